@@ -138,6 +138,10 @@ var (
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
 			gravityclient.ProposalHandler,
+			gravityclient.ReleaseQuarantinedDepositsProposalHandler,
+			gravityclient.ReturnQuarantinedDepositsProposalHandler,
+			gravityclient.AddEthereumDenylistProposalHandler,
+			gravityclient.RemoveEthereumDenylistProposalHandler,
 		}),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
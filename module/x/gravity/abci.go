@@ -5,8 +5,6 @@ import (
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
@@ -19,44 +17,54 @@ import (
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	cleanupTimedOutBatchTxs(ctx, k)
 	cleanupTimedOutContractCallTxs(ctx, k)
+	refundExpiredSendToEthereums(ctx, k)
 	createSignerSetTxs(ctx, k)
 	createBatchTxs(ctx, k)
 	pruneSignerSetTxs(ctx, k)
+	pruneSendToEthereumStatuses(ctx, k)
 }
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	outgoingTxSlashing(ctx, k)
+	k.SlashMissedEthereumEventVotes(ctx)
 	eventVoteRecordPruneAndTally(ctx, k)
+	k.EmitOracleLagEvents(ctx)
 	updateObservedEthereumHeight(ctx, k)
 }
 
+// createBatchTxs creates the batches whose batch trigger is met. Tokens with a batch trigger
+// or batch settings overrides are checked every block, the others only every
+// BatchCreationPeriod blocks
 func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
-	// TODO: this needs some more work, is super naive
 	params := k.GetParams(ctx)
 	// bridge is currently disabled, do not create batch anymore
 	if !params.BridgeActive {
 		return
 	}
+	cm := map[string]bool{}
+	for _, trigger := range params.BatchTriggers {
+		cm[common.HexToAddress(trigger.TokenContract).Hex()] = true
+	}
+	for _, override := range params.BatchSettingsOverrides {
+		cm[common.HexToAddress(override.TokenContract).Hex()] = true
+	}
 	period := int64(params.BatchCreationPeriod)
 	if ctx.BlockHeight()%period == 0 {
-		cm := map[string]bool{}
 		k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
-			cm[ste.Erc20Token.Contract] = true
+			cm[common.HexToAddress(ste.Erc20Token.Contract).Hex()] = true
 			return false
 		})
+	}
 
-		var contracts []string
-		for k := range cm {
-			contracts = append(contracts, k)
-		}
-		sort.Strings(contracts)
+	var contracts []string
+	for k := range cm {
+		contracts = append(contracts, k)
+	}
+	sort.Strings(contracts)
 
-		maxElement := int(params.BatchMaxElement)
-		for _, c := range contracts {
-			// NOTE: this doesn't emit events which would be helpful for client processes
-			k.BuildBatchTx(ctx, common.HexToAddress(c), maxElement)
-		}
+	for _, c := range contracts {
+		k.CreateTriggeredBatchTx(ctx, common.HexToAddress(c))
 	}
 }
 
@@ -70,7 +78,9 @@ func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
 	//      This will make sure the unbonding validator has to provide an ethereum signature to a new signer set tx
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of Current signer set and latest signer set request is > 5%
+	// 3. If power change between validators of Current signer set and latest signer set request is > SignerSetPowerDiffThreshold
+	// 4. If a bonded validator changed its ethereum address or was jailed since the latest signer set request
+	// 5. If the latest signer set request is SignerSetMaxAge blocks old or more
 	latestSignerSetTx := k.GetLatestSignerSetTx(ctx)
 	if latestSignerSetTx == nil {
 		k.CreateSignerSetTx(ctx)
@@ -78,15 +88,23 @@ func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	lastUnbondingHeight := k.GetLastUnbondingBlockHeight(ctx)
+	lastSignerSetChangeHeight := k.GetLastSignerSetChangeBlockHeight(ctx)
 	blockHeight := uint64(ctx.BlockHeight())
 	powerDiff := types.EthereumSigners(k.CurrentSignerSet(ctx)).PowerDiff(latestSignerSetTx.Signers)
+	signerSetAge := blockHeight - latestSignerSetTx.Height
 
-	shouldCreate := (lastUnbondingHeight == blockHeight) || (powerDiff > 0.05)
+	// a change recorded in the block the latest signer set was created in happened after its creation
+	shouldCreate := (lastUnbondingHeight == blockHeight) ||
+		(powerDiff > params.SignerSetPowerDiffThreshold.MustFloat64()) ||
+		(lastSignerSetChangeHeight != 0 && lastSignerSetChangeHeight >= latestSignerSetTx.Height) ||
+		(params.SignerSetMaxAge != 0 && signerSetAge >= params.SignerSetMaxAge)
 	k.Logger(ctx).Info(
 		"considering signer set tx creation",
 		"blockHeight", blockHeight,
 		"lastUnbondingHeight", lastUnbondingHeight,
+		"lastSignerSetChangeHeight", lastSignerSetChangeHeight,
 		"latestSignerSetTx.Nonce", latestSignerSetTx.Nonce,
+		"signerSetAge", signerSetAge,
 		"powerDiff", powerDiff,
 		"shouldCreate", shouldCreate,
 	)
@@ -96,6 +114,17 @@ func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	}
 }
 
+// pruneSendToEthereumStatuses deletes the statuses of the send to ethereums that left the bridge
+// SendToEthereumStatusRetention blocks ago or more
+func pruneSendToEthereumStatuses(ctx sdk.Context, k keeper.Keeper) {
+	retention := k.GetParams(ctx).SendToEthereumStatusRetention
+	blockHeight := uint64(ctx.BlockHeight())
+	if retention == 0 || blockHeight < retention {
+		return
+	}
+	k.PruneSendToEthereumStatuses(ctx, blockHeight-retention)
+}
+
 func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
 	if !params.BridgeActive {
@@ -151,6 +180,11 @@ func eventVoteRecordPruneAndTally(ctx sdk.Context, k keeper.Keeper) {
 	// a slice with one or more attestations at that event nonce. There can be multiple attestations
 	// at one event nonce when validators disagree about what event happened at that nonce.
 	for _, nonce := range keys {
+		// tally the votes for events that lost to the accepted one before they are deleted
+		if nonce < lastNonce {
+			k.SlashConflictingEventVotes(ctx, nonce, attmap[nonce])
+		}
+
 		// This iterates over all attestations at a particular event nonce.
 		// They are ordered by when the first attestation at the event nonce was received.
 		// This order is not important.
@@ -286,15 +320,51 @@ func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+	var timedOut []*types.BatchTx
+	k.IterateTimedOutOutgoingTxs(ctx, types.BatchTxPrefixByte, ethereumHeight, func(otx types.OutgoingTx) bool {
 		btx, _ := otx.(*types.BatchTx)
+		timedOut = append(timedOut, btx)
+		return false
+	})
+
+	for _, btx := range timedOut {
+		k.CancelBatchTx(ctx, btx)
+		refundTimedOutSendToEthereums(ctx, k, params, btx)
+	}
+}
 
-		if btx.Timeout < ethereumHeight {
-			k.CancelBatchTx(ctx, btx)
+// refundTimedOutSendToEthereums counts a batch timeout against every tx of a timed out batch, which
+// have just been returned to the pool, and refunds the ones that reached SendToEthereumMaxBatchTimeouts
+func refundTimedOutSendToEthereums(ctx sdk.Context, k keeper.Keeper, params types.Params, btx *types.BatchTx) {
+	for _, ste := range btx.Transactions {
+		timeouts := k.IncrementSendToEthereumBatchTimeouts(ctx, ste.Id)
+		if params.SendToEthereumMaxBatchTimeouts != 0 && timeouts >= params.SendToEthereumMaxBatchTimeouts {
+			k.RefundStaleSendToEthereum(ctx, ste, types.RefundReasonMaxBatchTimeouts)
 		}
+	}
+}
+
+// refundExpiredSendToEthereums refunds the unbatched txs that have been waiting in the pool for
+// SendToEthereumMaxPoolAge blocks or more
+func refundExpiredSendToEthereums(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+	if !params.BridgeActive || params.SendToEthereumMaxPoolAge == 0 {
+		return
+	}
+	blockHeight := uint64(ctx.BlockHeight())
+	if blockHeight < params.SendToEthereumMaxPoolAge {
+		return
+	}
 
+	var expired []*types.SendToEthereum
+	k.IterateUnbatchedSendToEthereumsByPoolHeight(ctx, blockHeight-params.SendToEthereumMaxPoolAge, func(ste *types.SendToEthereum) bool {
+		expired = append(expired, ste)
 		return false
 	})
+
+	for _, ste := range expired {
+		k.RefundStaleSendToEthereum(ctx, ste, types.RefundReasonMaxPoolAge)
+	}
 }
 
 // cleanupTimedOutContractCallTxs deletes logic calls that have passed their expiration on Ethereum
@@ -315,13 +385,15 @@ func cleanupTimedOutContractCallTxs(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
-	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
-		cctx, _ := otx.(*types.ContractCallTx)
-		if cctx.Timeout < ethereumHeight {
-			k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
-		}
+	var timedOut types.OutgoingTx
+	k.IterateTimedOutOutgoingTxs(ctx, types.ContractCallTxPrefixByte, ethereumHeight, func(otx types.OutgoingTx) bool {
+		timedOut = otx
 		return true
 	})
+
+	if timedOut != nil {
+		k.DeleteOutgoingTx(ctx, timedOut.GetStoreIndex())
+	}
 }
 
 func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
@@ -341,134 +413,23 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
-	// get signing info for each validator
-	type valInfo struct {
-		val   stakingtypes.Validator
-		exist bool
-		sigs  slashingtypes.ValidatorSigningInfo
-		cons  sdk.ConsAddress
-	}
-
-	bondedVals := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-	valInfos := make([]valInfo, len(bondedVals))
-
-	for i, val := range bondedVals {
-		consAddr, err := val.GetConsAddr()
-		if err != nil {
-			k.DisableBridge(ctx)
-			k.Logger(ctx).Error(
-				fmt.Sprintf("outgoingTxSlashing: failed to get consensus address: %s", err))
-			return
-		}
-
-		sigs, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-		valInfos[i] = valInfo{val, exist, sigs, consAddr}
-	}
-
-	var unbondingValInfos []valInfo
-
-	blockTime := ctx.BlockTime().Add(k.StakingKeeper.GetParams(ctx).UnbondingTime)
-	blockHeight := ctx.BlockHeight()
-	unbondingValIterator := k.StakingKeeper.ValidatorQueueIterator(ctx, blockTime, blockHeight)
-	defer unbondingValIterator.Close()
-
-	// All unbonding validators
-	for ; unbondingValIterator.Valid(); unbondingValIterator.Next() {
-		unbondingValidators := k.GetUnbondingvalidators(unbondingValIterator.Value())
-		for _, valAddr := range unbondingValidators.Addresses {
-			addr, err := sdk.ValAddressFromBech32(valAddr)
-			if err != nil {
-				k.DisableBridge(ctx)
-				k.Logger(ctx).Error(
-					fmt.Sprintf("outgoingTxSlashing: failed to bech32 decode validator address: %s", err))
-				return
-			}
-
-			validator, _ := k.StakingKeeper.GetValidator(ctx, addr)
-
-			valConsAddr, err := validator.GetConsAddr()
-			if err != nil {
-				k.DisableBridge(ctx)
-				k.Logger(ctx).Error(
-					fmt.Sprintf("outgoingTxSlashing: failed to get validator consensus address: %s", err))
-				return
-			}
-
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, valConsAddr)
-			unbondingValInfos = append(unbondingValInfos, valInfo{validator, exist, valSigningInfo, valConsAddr})
-		}
+	signers, err := k.GetOutgoingTxSigners(ctx)
+	if err != nil {
+		k.DisableBridge(ctx)
+		k.Logger(ctx).Error(fmt.Sprintf("outgoingTxSlashing: %s", err))
+		return
 	}
 
-	slashedVals := make(map[string]bool)
-
 	for _, otx := range usotxs {
-		// SLASH BONDED VALIDATORS who didn't sign batch txs
+		// Count the signatures of bonded and unbonding validators in their bridge signing info
 		signatures := k.GetEthereumSignatures(ctx, otx.GetStoreIndex())
-		for _, valInfo := range valInfos {
-			// Don't slash validators who joined after outgoingtx is created
-			if valInfo.exist && valInfo.sigs.StartHeight < int64(otx.GetCosmosHeight()) {
-				if _, ok := signatures[valInfo.val.GetOperator().String()]; !ok {
-					// Don't slash already slashed validators
-					if !valInfo.val.IsJailed() && !slashedVals[valInfo.cons.String()] {
-						power := valInfo.val.ConsensusPower(k.PowerReduction)
-						k.StakingKeeper.Slash(
-							ctx,
-							valInfo.cons,
-							ctx.BlockHeight(),
-							power,
-							params.SlashFractionBatch,
-						)
-						k.StakingKeeper.Jail(ctx, valInfo.cons)
-						slashedVals[valInfo.cons.String()] = true
-
-						ctx.EventManager().EmitEvent(
-							sdk.NewEvent(
-								slashingtypes.EventTypeSlash,
-								sdk.NewAttribute(slashingtypes.AttributeKeyAddress, valInfo.cons.String()),
-								sdk.NewAttribute(slashingtypes.AttributeKeyJailed, valInfo.cons.String()),
-								sdk.NewAttribute(slashingtypes.AttributeKeyReason, types.AttributeMissingBridgeBatchSig),
-								sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
-							),
-						)
-					}
-				}
-			}
-		}
-
-		if sstx, ok := otx.(*types.SignerSetTx); ok {
-			for _, valInfo := range unbondingValInfos {
-				// Only slash validators who joined after valset is created and they are
-				// unbonding and UNBOND_SLASHING_WINDOW didn't pass.
-				if valInfo.exist && valInfo.sigs.StartHeight < int64(sstx.Height) &&
-					valInfo.val.IsUnbonding() &&
-					sstx.Height < uint64(valInfo.val.UnbondingHeight)+params.UnbondSlashingSignerSetTxsWindow {
-					// check if validator has confirmed valset or not
-					if _, found := signatures[valInfo.val.GetOperator().String()]; !found {
-						// Don't slash already slashed validators
-						if !valInfo.val.IsJailed() && !slashedVals[valInfo.cons.String()] {
-							// TODO: Do we want to slash jailed validators?
-							power := valInfo.val.ConsensusPower(k.PowerReduction)
-							k.StakingKeeper.Slash(
-								ctx,
-								valInfo.cons,
-								ctx.BlockHeight(),
-								power,
-								params.SlashFractionSignerSetTx,
-							)
-							k.StakingKeeper.Jail(ctx, valInfo.cons)
-							slashedVals[valInfo.cons.String()] = true
-
-							ctx.EventManager().EmitEvent(
-								sdk.NewEvent(
-									slashingtypes.EventTypeSlash,
-									sdk.NewAttribute(slashingtypes.AttributeKeyAddress, valInfo.cons.String()),
-									sdk.NewAttribute(slashingtypes.AttributeKeyJailed, valInfo.cons.String()),
-									sdk.NewAttribute(slashingtypes.AttributeKeyReason, types.AttributeMissingBridgeBatchSig),
-									sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
-								),
-							)
-						}
-					}
+		for _, signer := range signers {
+			if signer.ExpectedToSign(otx, params.UnbondSlashingSignerSetTxsWindow) {
+				_, signed := signatures[signer.Validator.GetOperator().String()]
+				if err := k.HandleOutgoingTxSignature(ctx, signer.Validator, otx, !signed); err != nil {
+					k.DisableBridge(ctx)
+					k.Logger(ctx).Error(fmt.Sprintf("outgoingTxSlashing: %s", err))
+					return
 				}
 			}
 		}
@@ -3,10 +3,12 @@ package gravity_test
 import (
 	"fmt"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -65,11 +67,13 @@ func TestPruneSignerSetTxs(t *testing.T) {
 	signatures := gravityKeeper.GetEthereumSignatures(ctx, types.MakeSignerSetTxKey(1))
 	require.Equal(t, 1, len(signatures))
 
-	// Handle outgoing tx event
-	gravityKeeper.Handle(ctx, &types.SignerSetTxExecutedEvent{
+	// Handle outgoing tx event of a newer signer set
+	nextSignerSetTx := gravityKeeper.CreateSignerSetTx(ctx)
+	require.NoError(t, gravityKeeper.Handle(ctx, &types.SignerSetTxExecutedEvent{
 		EventNonce:       1,
-		SignerSetTxNonce: signerSetTx.Nonce + 1,
-	})
+		SignerSetTxNonce: nextSignerSetTx.Nonce,
+		Members:          nextSignerSetTx.Signers,
+	}))
 
 	// Define new height for the pruning to happen
 	prunedHeight := uint64(ctx.BlockHeight()) + (params.SignedSignerSetTxsWindow + 1)
@@ -81,6 +85,8 @@ func TestPruneSignerSetTxs(t *testing.T) {
 	// Check that signatures are pruned as well
 	signatures = gravityKeeper.GetEthereumSignatures(newCtx, types.MakeSignerSetTxKey(1))
 	require.Equal(t, 0, len(signatures))
+	// the hash of the signer set is kept to check it against a late observation
+	require.Equal(t, signerSetTx.Signers.Hash(), gravityKeeper.GetSignerSetHash(newCtx, signerSetTx.Nonce))
 }
 
 func TestSignerSetTxCreationUponUnbonding(t *testing.T) {
@@ -104,6 +110,45 @@ func TestSignerSetTxCreationUponUnbonding(t *testing.T) {
 	require.EqualValues(t, 2, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
 }
 
+func TestSignerSetTxCreationTriggers(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+
+	// a threshold no power change reaches, to only exercise the other triggers
+	params := gravityKeeper.GetParams(ctx)
+	params.SignerSetPowerDiffThreshold = sdk.OneDec()
+	params.SignerSetMaxAge = 100
+	gravityKeeper.SetParams(ctx, params)
+
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 1, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
+
+	// jailing a validator takes it out of the bonded set at the end of the block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	consAddr, err := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Jail(ctx, consAddr)
+	staking.EndBlocker(ctx, &input.StakingKeeper)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 2, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
+	require.Len(t, gravityKeeper.GetLatestSignerSetTx(ctx).Signers, 4)
+
+	// nothing changed since
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 2, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
+
+	// the signer set is refreshed once it reaches the max age
+	ctx = ctx.WithBlockHeight(int64(gravityKeeper.GetLatestSignerSetTx(ctx).Height + params.SignerSetMaxAge - 1))
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 2, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 3, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
+}
+
 func TestSignerSetTxSlashing_SignerSetTxCreated_Before_ValidatorBonded(t *testing.T) {
 	//	Don't slash validators if signer set tx is created before he is bonded.
 
@@ -367,6 +412,118 @@ func TestBatchTxTimeout(t *testing.T) {
 	require.NotNil(t, gotThirdBatch)
 }
 
+func TestSendToEthereumMaxBatchTimeouts(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+
+	params := gravityKeeper.GetParams(ctx)
+	params.SendToEthereumMaxBatchTimeouts = 2
+	gravityKeeper.SetParams(ctx, params)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	// avoid the batch creation period so that only the batches built here exist
+	ctx = ctx.WithBlockHeight(101)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+
+	// first timeout returns the txs to the pool
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 500)
+	require.NotNil(t, gravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 2))
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 5000)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+
+	var pooled []uint64
+	gravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		pooled = append(pooled, ste.Id)
+		return false
+	})
+	require.Len(t, pooled, 2)
+	for _, id := range pooled {
+		require.Equal(t, uint64(1), gravityKeeper.GetSendToEthereumBatchTimeouts(ctx, id))
+	}
+
+	// second timeout reaches the limit and refunds the txs
+	require.NotNil(t, gravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 2))
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 10000)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+
+	gravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		require.Fail(t, "unexpected tx in pool", "id %d", ste.Id)
+		return false
+	})
+	for _, id := range pooled {
+		require.Equal(t, uint64(0), gravityKeeper.GetSendToEthereumBatchTimeouts(ctx, id))
+	}
+	require.Equal(t, allVouchers, input.BankKeeper.GetAllBalances(ctx, mySender))
+}
+
+func TestSendToEthereumMaxPoolAge(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+
+	params := gravityKeeper.GetParams(ctx)
+	params.SendToEthereumMaxPoolAge = 100
+	gravityKeeper.SetParams(ctx, params)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	// avoid the batch creation period so that the txs stay in the pool
+	ctx = ctx.WithBlockHeight(101)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+	ctx = ctx.WithBlockHeight(151)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 4)
+
+	ctx = ctx.WithBlockHeight(199)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	var pooled []uint64
+	gravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		pooled = append(pooled, ste.Id)
+		return false
+	})
+	require.Len(t, pooled, 3)
+
+	ctx = ctx.WithBlockHeight(201)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	pooled = nil
+	gravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		pooled = append(pooled, ste.Id)
+		return false
+	})
+	require.Equal(t, []uint64{3}, pooled)
+
+	refunded := ctx.EventManager().Events()
+	var reasons []string
+	for _, event := range refunded {
+		if event.Type != types.EventTypeBridgeWithdrawRefunded {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyRefundReason {
+				reasons = append(reasons, attr.Value)
+			}
+		}
+	}
+	require.Equal(t, []string{types.RefundReasonMaxPoolAge, types.RefundReasonMaxPoolAge}, reasons)
+
+	// the remaining tx is 100 plus a fee of 4
+	expected := allVouchers.Sub(types.NewERC20Token(104, myTokenContractAddr).GravityCoin())
+	require.Equal(t, expected, input.BankKeeper.GetAllBalances(ctx, mySender))
+}
+
 func TestUpdateObservedEthereumHeight(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gravityKeeper := input.GravityKeeper
@@ -425,3 +582,394 @@ func fundAccount(ctx sdk.Context, bankKeeper types.BankKeeper, addr sdk.AccAddre
 
 	return bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amounts)
 }
+
+func TestCreateBatchTxsTriggers(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	setTrigger := func(trigger types.BatchTrigger) {
+		trigger.TokenContract = myTokenContractAddr.Hex()
+		params := gravityKeeper.GetParams(ctx)
+		params.BatchTriggers = []types.BatchTrigger{trigger}
+		gravityKeeper.SetParams(ctx, params)
+	}
+	triggered := func() []string {
+		var reasons []string
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeBatchTriggered {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key == types.AttributeKeyBatchTriggerReason {
+					reasons = append(reasons, attr.Value)
+				}
+			}
+		}
+		return reasons
+	}
+
+	// the heights avoid the batch creation period, triggered tokens are checked every block
+	setTrigger(types.BatchTrigger{MinFees: sdk.NewInt(10), MaxOutstandingBatches: 1})
+	ctx = ctx.WithBlockHeight(101)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Empty(t, triggered())
+
+	ctx = ctx.WithBlockHeight(103)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 6)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Equal(t, []string{types.BatchTriggerReasonMinFees}, triggered())
+
+	// the token already has the maximum number of outstanding batches
+	ctx = ctx.WithBlockHeight(105)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 7, 8)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Equal(t, []string{types.BatchTriggerReasonMinFees}, triggered())
+
+	setTrigger(types.BatchTrigger{MinFees: sdk.ZeroInt(), MaxPoolAge: 50})
+	ctx = ctx.WithBlockHeight(154)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Equal(t, []string{types.BatchTriggerReasonMinFees}, triggered())
+	ctx = ctx.WithBlockHeight(155)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Equal(t, []string{types.BatchTriggerReasonMinFees, types.BatchTriggerReasonMaxPoolAge}, triggered())
+
+	setTrigger(types.BatchTrigger{MinFees: sdk.ZeroInt(), MinPoolSize: 2})
+	ctx = ctx.WithBlockHeight(156)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 1)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Len(t, triggered(), 2)
+	ctx = ctx.WithBlockHeight(157)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 1)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Equal(t, []string{
+		types.BatchTriggerReasonMinFees,
+		types.BatchTriggerReasonMaxPoolAge,
+		types.BatchTriggerReasonMinPoolSize,
+	}, triggered())
+
+	var pooled int
+	gravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(*types.SendToEthereum) bool {
+		pooled++
+		return false
+	})
+	require.Zero(t, pooled)
+}
+
+func TestContractCallTxTimeout(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	gravityKeeper := input.GravityKeeper
+	scope := []byte("test-scope")
+
+	for nonce, timeout := range []uint64{300, 100, 200} {
+		gravityKeeper.SetOutgoingTx(ctx, &types.ContractCallTx{
+			InvalidationScope: scope,
+			InvalidationNonce: uint64(nonce + 1),
+			Timeout:           timeout,
+			Height:            1,
+		})
+	}
+	pending := func() (nonces []uint64) {
+		gravityKeeper.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+			nonces = append([]uint64{otx.(*types.ContractCallTx).InvalidationNonce}, nonces...)
+			return false
+		})
+		return nonces
+	}
+
+	// nothing has timed out yet
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 100)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Equal(t, []uint64{1, 2, 3}, pending())
+
+	// a single call is cleaned up per block, earliest timeout first
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 250)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Equal(t, []uint64{1, 3}, pending())
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Equal(t, []uint64{1}, pending())
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Equal(t, []uint64{1}, pending())
+}
+
+// BenchmarkBeginBlockerOutgoingTxs measures the cost of a block as the number of outgoing batch
+// and contract call txs waiting to be executed on ethereum grows
+func BenchmarkBeginBlockerOutgoingTxs(b *testing.B) {
+	for _, count := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("outgoing_txs=%d", count), func(b *testing.B) {
+			input := keeper.CreateTestEnv(b)
+			ctx := input.Context
+			gravityKeeper := input.GravityKeeper
+			gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 100)
+
+			for i := 0; i < count; i++ {
+				tokenContract := common.BigToAddress(big.NewInt(int64(i%10 + 1)))
+				gravityKeeper.SetOutgoingTx(ctx, &types.BatchTx{
+					BatchNonce:    uint64(i + 1),
+					TokenContract: tokenContract.Hex(),
+					Timeout:       uint64(1000 + i),
+					Height:        1,
+				})
+				gravityKeeper.SetOutgoingTx(ctx, &types.ContractCallTx{
+					InvalidationScope: tokenContract.Bytes(),
+					InvalidationNonce: uint64(i + 1),
+					Timeout:           uint64(1000 + i),
+					Height:            1,
+				})
+			}
+			// iterating uncommitted writes is linear in their number, unlike a committed store
+			ctx.MultiStore().GetKVStore(input.GravityStoreKey).(storetypes.Committer).Commit()
+			// off the batch creation period, so only the cleanups depend on the outgoing txs
+			ctx = ctx.WithBlockHeight(11)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				gravity.BeginBlocker(cacheCtx, gravityKeeper)
+			}
+		})
+	}
+}
+
+// BenchmarkEndBlockerOutgoingTxSlashing measures the cost of a block as the number of pending
+// batches grows, none of which have entered the slashing window yet
+func BenchmarkEndBlockerOutgoingTxSlashing(b *testing.B) {
+	for _, count := range []int{1000, 5000, 10000} {
+		b.Run(fmt.Sprintf("pending_batches=%d", count), func(b *testing.B) {
+			input := keeper.CreateTestEnv(b)
+			gravityKeeper := input.GravityKeeper
+			params := gravityKeeper.GetParams(input.Context)
+			height := int64(params.SignedBatchesWindow) + 100
+			ctx := input.Context.WithBlockHeight(height)
+
+			for i := 0; i < count; i++ {
+				gravityKeeper.SetOutgoingTx(ctx, &types.BatchTx{
+					BatchNonce:    uint64(i + 1),
+					TokenContract: common.BigToAddress(big.NewInt(int64(i%10 + 1))).Hex(),
+					Height:        uint64(height) - uint64(i)%params.SignedBatchesWindow,
+				})
+			}
+			// iterating uncommitted writes is linear in their number, unlike a committed store
+			ctx.MultiStore().GetKVStore(input.GravityStoreKey).(storetypes.Committer).Commit()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				gravity.EndBlocker(cacheCtx, gravityKeeper)
+			}
+		})
+	}
+}
+
+func TestConflictingEventVoteSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	msgServer := keeper.NewMsgServerImpl(gravityKeeper)
+
+	params := gravityKeeper.GetParams(ctx)
+	params.ConflictingEthereumEventSlashing = true
+	params.ConflictingEthereumEventGraceVotes = 1
+	gravityKeeper.SetParams(ctx, params)
+
+	vote := func(i int, nonce uint64, amount int64) {
+		event, err := types.PackEvent(&types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: keeper.EthAddrs[0].Hex(),
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+			EthereumHeight: 100 + nonce,
+		})
+		require.NoError(t, err)
+		_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumEvent{
+			Event:  event,
+			Signer: keeper.AccAddrs[i].String(),
+		})
+		require.NoError(t, err)
+	}
+
+	// the last validator votes for other events than the rest at the first two nonces
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		for i := 0; i < 4; i++ {
+			vote(i, nonce, 1000)
+		}
+	}
+	vote(4, 1, 5000)
+	vote(4, 2, 5000)
+
+	// the events are accepted, their vote records are tallied once a later nonce is observed
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.Equal(t, uint64(3), gravityKeeper.GetLastObservedEventNonce(ctx))
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).IsJailed())
+
+	// the first conflicting vote is within the grace votes, the second is slashed
+	tokensBefore := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens()
+	gravity.EndBlocker(ctx, gravityKeeper)
+	validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4])
+	require.True(t, validator.IsJailed())
+	require.True(t, validator.GetTokens().LT(tokensBefore))
+	for i := 0; i < 4; i++ {
+		require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[i]).IsJailed())
+	}
+}
+
+func TestConflictingEventVoteSlashingDisabled(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	msgServer := keeper.NewMsgServerImpl(gravityKeeper)
+
+	params := gravityKeeper.GetParams(ctx)
+	params.ConflictingEthereumEventGraceVotes = 0
+	gravityKeeper.SetParams(ctx, params)
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		for i := range keeper.ValAddrs {
+			amount := int64(1000)
+			if i == 4 && nonce == 1 {
+				amount = 5000
+			}
+			event, err := types.PackEvent(&types.SendToCosmosEvent{
+				EventNonce:     nonce,
+				TokenContract:  keeper.TokenContractAddrs[0],
+				Amount:         sdk.NewInt(amount),
+				EthereumSender: keeper.EthAddrs[0].Hex(),
+				CosmosReceiver: keeper.AccAddrs[0].String(),
+				EthereumHeight: 100 + nonce,
+			})
+			require.NoError(t, err)
+			_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumEvent{
+				Event:  event,
+				Signer: keeper.AccAddrs[i].String(),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	gravity.EndBlocker(ctx, gravityKeeper)
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.Equal(t, uint64(2), gravityKeeper.GetLastObservedEventNonce(ctx))
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).IsJailed())
+}
+
+func TestMissedEthereumEventVoteSlashing(t *testing.T) {
+	for mode, slashed := range map[types.EthereumEventVoteSlashingMode]bool{
+		types.EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_JAIL:  false,
+		types.EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_SLASH: true,
+	} {
+		t.Run(mode.String(), func(t *testing.T) {
+			input, ctx := keeper.SetupFiveValChain(t)
+			gravityKeeper := input.GravityKeeper
+			msgServer := keeper.NewMsgServerImpl(gravityKeeper)
+
+			params := gravityKeeper.GetParams(ctx)
+			params.EthereumEventVoteSlashingMode = mode
+			gravityKeeper.SetParams(ctx, params)
+
+			// the last validator does not vote on the event
+			for i := 0; i < 4; i++ {
+				event, err := types.PackEvent(&types.SendToCosmosEvent{
+					EventNonce:     1,
+					TokenContract:  keeper.TokenContractAddrs[0],
+					Amount:         sdk.NewInt(1000),
+					EthereumSender: keeper.EthAddrs[0].Hex(),
+					CosmosReceiver: keeper.AccAddrs[0].String(),
+					EthereumHeight: 100,
+				})
+				require.NoError(t, err)
+				_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumEvent{
+					Event:  event,
+					Signer: keeper.AccAddrs[i].String(),
+				})
+				require.NoError(t, err)
+			}
+			gravity.EndBlocker(ctx, gravityKeeper)
+			acceptedHeight := ctx.BlockHeight()
+
+			res, err := gravityKeeper.EthereumEventParticipation(sdk.WrapSDKContext(ctx), &types.EthereumEventParticipationRequest{
+				ValidatorAddress: keeper.ValAddrs[4].String(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), res.LastObservedEventNonce)
+			require.Equal(t, uint64(1), res.Participation[0].MissedEvents)
+			require.Equal(t, uint64(acceptedHeight), res.Participation[0].OldestMissedEventHeight)
+
+			// the validator has the window to vote
+			tokensBefore := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens()
+			ctx = ctx.WithBlockHeight(acceptedHeight + int64(params.EthereumSignaturesWindow))
+			gravity.EndBlocker(ctx, gravityKeeper)
+			require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).IsJailed())
+
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			gravity.EndBlocker(ctx, gravityKeeper)
+			validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4])
+			require.True(t, validator.IsJailed())
+			require.Equal(t, slashed, validator.GetTokens().LT(tokensBefore))
+			for i := 0; i < 4; i++ {
+				require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[i]).IsJailed())
+			}
+
+			// the missed event is no longer held against the validator
+			res, err = gravityKeeper.EthereumEventParticipation(sdk.WrapSDKContext(ctx), &types.EthereumEventParticipationRequest{
+				ValidatorAddress: keeper.ValAddrs[4].String(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), res.Participation[0].ExcusedEventNonce)
+			require.Zero(t, res.Participation[0].OldestMissedEventHeight)
+		})
+	}
+}
+
+func TestMissedEthereumEventVoteWindowRestartsAfterBridgeDisabled(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	msgServer := keeper.NewMsgServerImpl(gravityKeeper)
+
+	params := gravityKeeper.GetParams(ctx)
+	params.EthereumEventVoteSlashingMode = types.EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_JAIL
+	gravityKeeper.SetParams(ctx, params)
+
+	for i := 0; i < 4; i++ {
+		event, err := types.PackEvent(&types.SendToCosmosEvent{
+			EventNonce:     1,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         sdk.NewInt(1000),
+			EthereumSender: keeper.EthAddrs[0].Hex(),
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+			EthereumHeight: 100,
+		})
+		require.NoError(t, err)
+		_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumEvent{
+			Event:  event,
+			Signer: keeper.AccAddrs[i].String(),
+		})
+		require.NoError(t, err)
+	}
+	gravity.EndBlocker(ctx, gravityKeeper)
+	acceptedHeight := ctx.BlockHeight()
+
+	// the bridge is disabled for longer than the window
+	gravityKeeper.DisableBridge(ctx)
+	ctx = ctx.WithBlockHeight(acceptedHeight + int64(params.EthereumSignaturesWindow) + 5)
+	gravity.EndBlocker(ctx, gravityKeeper)
+	params = gravityKeeper.GetParams(ctx)
+	params.BridgeActive = true
+	gravityKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.EthereumSignaturesWindow))
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).IsJailed())
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).IsJailed())
+}
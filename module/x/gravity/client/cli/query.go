@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -12,6 +14,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const flagQuarantineStatus = "status"
+
 func GetQueryCmd() *cobra.Command {
 	gravityQueryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -25,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 		CmdLastBatchTx(),
 		CmdBatchTxConfirmations(),
 		CmdBatchTxFees(),
+		CmdSimulateBatchTx(),
+		CmdSuggestedBridgeFee(),
 		CmdBatchTxs(),
 		CmdContractCallTx(),
 		CmdContractCallTxConfirmations(),
@@ -42,11 +48,27 @@ func GetQueryCmd() *cobra.Command {
 		CmdUnsignedSignerSetTxs(),
 		CmdDenomToERC20(),
 		CmdUnbatchedSendToEthereums(),
+		CmdUnbatchedSendToEthereumsByRecipient(),
+		CmdSendToEthereumByID(),
+		CmdSendToEthereumStatus(),
+		CmdSendToEthereumQueuePosition(),
 		CmdDelegateKeysByValidator(),
 		CmdDelegateKeysByEthereumSigner(),
 		CmdDelegateKeysByOrchestrator(),
 		CmdDelegateKeys(),
 		CmdLastObservedEthereumHeight(),
+		CmdMinBridgeFees(),
+		CmdBatchSettings(),
+		CmdQuarantinedDeposits(),
+		CmdQuarantinedDeposit(),
+		CmdEthereumDenylist(),
+		CmdSignerSetHijackIncidents(),
+		CmdEthereumEventParticipation(),
+		CmdBridgeSigningInfos(),
+		CmdSlashingPreview(),
+		CmdEthereumEventVoteRecords(),
+		CmdEthereumEventVoteRecord(),
+		CmdOracleLagReport(),
 	)
 
 	return gravityQueryCmd
@@ -587,6 +609,72 @@ func CmdBatchTxFees() *cobra.Command {
 	return cmd
 }
 
+func CmdSimulateBatchTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-batch-tx [token-contract]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the batch a batch request for a token would create, without creating it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("%s not a valid ethereum address, please input a valid ethereum address", args[0])
+			}
+
+			res, err := queryClient.SimulateBatchTx(cmd.Context(), &types.SimulateBatchTxRequest{TokenContract: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSuggestedBridgeFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suggested-bridge-fee [token-contract] [target-blocks]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "query bridge fee suggestions for a token from the fees of its recently executed batches",
+		Long: `Query bridge fee suggestions for a token from the fees of its recently executed batches.
+If target-blocks is given, only batches executed within that many blocks of their creation are considered.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("%s not a valid ethereum address, please input a valid ethereum address", args[0])
+			}
+
+			req := &types.SuggestedBridgeFeeRequest{TokenContract: args[0]}
+			if len(args) == 2 {
+				req.TargetBlocks, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("target blocks %s not a valid uint, please input a valid number of blocks", args[1])
+				}
+			}
+
+			res, err := queryClient.SuggestedBridgeFee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdERC20ToDenom() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "erc20-to-denom [erc20]",
@@ -721,6 +809,130 @@ func CmdUnbatchedSendToEthereums() *cobra.Command {
 	return cmd
 }
 
+func CmdUnbatchedSendToEthereumsByRecipient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbatched-send-to-ethereums-by-recipient [ethereum-recipient]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query all unbatched send to ethereum messages to an ethereum recipient",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("%s not a valid ethereum address, please input a valid ethereum address", args[0])
+			}
+
+			res, err := queryClient.UnbatchedSendToEthereumsByRecipient(cmd.Context(), &types.UnbatchedSendToEthereumsByRecipientRequest{
+				EthereumRecipient: args[0],
+				Pagination:        pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbatched-send-to-ethereums-by-recipient")
+	return cmd
+}
+
+func CmdSendToEthereumByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-to-ethereum [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query an unbatched send to ethereum message by id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id %s not a valid uint, please input a valid id", args[0])
+			}
+
+			res, err := queryClient.SendToEthereumByID(cmd.Context(), &types.SendToEthereumByIDRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSendToEthereumStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-to-ethereum-status [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the lifecycle status of a send to ethereum message by id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id %s not a valid uint, please input a valid id", args[0])
+			}
+
+			res, err := queryClient.SendToEthereumStatus(cmd.Context(), &types.SendToEthereumStatusRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSendToEthereumQueuePosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-to-ethereum-queue-position [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the position of a pooled send to ethereum message and an estimate of its wait",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id %s not a valid uint, please input a valid id", args[0])
+			}
+
+			res, err := queryClient.SendToEthereumQueuePosition(cmd.Context(), &types.SendToEthereumQueuePositionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdDelegateKeysByValidator() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delegate-keys-by-validator [validator-address]",
@@ -863,6 +1075,360 @@ func CmdLastObservedEthereumHeight() *cobra.Command {
 	return cmd
 }
 
+func CmdMinBridgeFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-bridge-fees",
+		Args:  cobra.NoArgs,
+		Short: "query the minimum bridge fee of each token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MinBridgeFees(cmd.Context(), &types.MinBridgeFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBatchSettings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-settings [token-contract]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the batch max elements, timeout and creation period in effect for a token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("%s not a valid ethereum address, please input a valid ethereum address", args[0])
+			}
+
+			res, err := queryClient.BatchSettings(cmd.Context(), &types.BatchSettingsRequest{TokenContract: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQuarantinedDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantined-deposits",
+		Args:  cobra.NoArgs,
+		Short: "query deposits quarantined by an inflow limit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := cmd.Flags().GetString(flagQuarantineStatus)
+			if err != nil {
+				return err
+			}
+			status := types.QuarantineStatus_QUARANTINE_STATUS_UNSPECIFIED
+			if statusStr != "" {
+				value, ok := types.QuarantineStatus_value["QUARANTINE_STATUS_"+strings.ToUpper(statusStr)]
+				if !ok {
+					return fmt.Errorf("status %s not valid, please input quarantined, released or returned", statusStr)
+				}
+				status = types.QuarantineStatus(value)
+			}
+
+			res, err := queryClient.QuarantinedDeposits(cmd.Context(), &types.QuarantinedDepositsRequest{
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagQuarantineStatus, "", "only list deposits with this status: quarantined, released or returned")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "quarantined-deposits")
+	return cmd
+}
+
+func CmdQuarantinedDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantined-deposit [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query a deposit quarantined by an inflow limit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id %s not a valid uint, please input a valid id", args[0])
+			}
+
+			res, err := queryClient.QuarantinedDeposit(cmd.Context(), &types.QuarantinedDepositRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdEthereumDenylist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ethereum-denylist",
+		Args:  cobra.NoArgs,
+		Short: "query the ethereum addresses withdrawals are denied to",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EthereumDenylist(cmd.Context(), &types.EthereumDenylistRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ethereum-denylist")
+	return cmd
+}
+
+func CmdSignerSetHijackIncidents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-hijack-incidents",
+		Args:  cobra.NoArgs,
+		Short: "query the observed signer sets that did not match the ones the module created",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSetHijackIncidents(cmd.Context(), &types.SignerSetHijackIncidentsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signer-set-hijack-incidents")
+	return cmd
+}
+
+func CmdEthereumEventParticipation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ethereum-event-participation [validator-address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query how far behind the accepted events the votes of the bonded validators, or of a single validator, are",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := types.EthereumEventParticipationRequest{}
+			if len(args) == 1 {
+				req.ValidatorAddress = args[0]
+			}
+
+			res, err := queryClient.EthereumEventParticipation(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBridgeSigningInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-signing-infos [validator-address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query the outgoing txs all validators, or a single validator, missed signing within the bridge signing window",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.BridgeSigningInfosRequest{Pagination: pageReq}
+			if len(args) == 1 {
+				req.ValidatorAddress = args[0]
+			}
+
+			res, err := queryClient.BridgeSigningInfos(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bridge-signing-infos")
+	return cmd
+}
+
+func CmdSlashingPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-preview [validator-address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query the outgoing txs validators, or a single validator, have not signed yet that will become slashable, and the penalties that would apply",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := types.SlashingPreviewRequest{}
+			if len(args) == 1 {
+				req.ValidatorAddress = args[0]
+			}
+
+			res, err := queryClient.SlashingPreview(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdEthereumEventVoteRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ethereum-event-vote-records [event-nonce]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query the event vote records, or the ones at an event nonce, with their tallied power and the bonded validators that have not voted yet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.EthereumEventVoteRecordsRequest{Pagination: pageReq}
+			if len(args) == 1 {
+				if req.EventNonce, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+					return fmt.Errorf("event nonce %s not a valid uint, please input a valid event nonce", args[0])
+				}
+			}
+
+			res, err := queryClient.EthereumEventVoteRecords(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ethereum-event-vote-records")
+	return cmd
+}
+
+func CmdEthereumEventVoteRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ethereum-event-vote-record [event-nonce] [event-hash]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the event vote record of an event by its nonce and hex encoded hash",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			eventNonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("event nonce %s not a valid uint, please input a valid event nonce", args[0])
+			}
+			eventHash, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return fmt.Errorf("event hash %s not valid hex, please input a valid event hash", args[1])
+			}
+
+			res, err := queryClient.EthereumEventVoteRecord(cmd.Context(), &types.EthereumEventVoteRecordRequest{
+				EventNonce: eventNonce,
+				EventHash:  eventHash,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func newContextAndQueryClient(cmd *cobra.Command) (client.Context, types.QueryClient, error) {
 	clientCtx, err := client.GetClientQueryContext(cmd)
 	if err != nil {
@@ -893,3 +1459,27 @@ func parseNonce(s string) (uint64, error) {
 	}
 	return nonce, nil
 }
+
+func CmdOracleLagReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-lag-report",
+		Args:  cobra.NoArgs,
+		Short: "query how far behind the last observed event nonce each bonded validator's orchestrator is",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OracleLagReport(cmd.Context(), &types.OracleLagReportRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -30,8 +32,10 @@ func GetTxCmd(storeKey string) *cobra.Command {
 	gravityTxCmd.AddCommand(
 		CmdSendToEthereum(),
 		CmdCancelSendToEthereum(),
+		CmdIncreaseBridgeFee(),
 		CmdRequestBatchTx(),
 		CmdSetDelegateKeys(),
+		CmdSubmitBadSignatureEvidence(),
 	)
 
 	return gravityTxCmd
@@ -116,6 +120,45 @@ func CmdCancelSendToEthereum() *cobra.Command {
 	return cmd
 }
 
+func CmdIncreaseBridgeFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase-bridge-fee [id] [fee-increase]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add to the bridge fee of an unbatched ethereum send by id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			feeIncrease, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgIncreaseBridgeFee(id, from, feeIncrease)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdRequestBatchTx() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "request-batch-tx [denom] [signer]",
@@ -192,6 +235,76 @@ the validator's address and operator account current nonce.`,
 	return cmd
 }
 
+func CmdSubmitBadSignatureEvidence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-bad-signature-evidence [outgoing-tx-file] [ethereum-signature] [ethereum-signer]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit evidence of an Ethereum signature over an outgoing tx the bridge never created",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit evidence that a validator's Ethereum key signed over the checkpoint of an
+outgoing tx the gravity module never created. The validator owning the key is slashed and jailed.
+
+Example:
+$ %s tx gravity submit-bad-signature-evidence <path/to/batch.json> 0x... 0x... --from=<key_or_address>
+
+Where batch.json contains the outgoing tx in its JSON encoding:
+
+{
+	"@type": "/gravity.v1.BatchTx",
+	"batch_nonce": "100",
+	"timeout": "1000",
+	"transactions": [],
+	"token_contract": "0x0000000000000000000000000000000000000000",
+	"height": "0"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var subject types.OutgoingTx
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &subject); err != nil {
+				return err
+			}
+
+			any, err := types.PackOutgoingTx(subject)
+			if err != nil {
+				return err
+			}
+
+			signature, err := hexutil.Decode(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitBadSignatureEvidence{
+				Subject:        any,
+				Signature:      signature,
+				EthereumSigner: args[2],
+				Sender:         clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdSubmitCommunityPoolEthereumSpendProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "community-pool-ethereum-spend [proposal-file]",
@@ -278,3 +391,191 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+func CmdSubmitReleaseQuarantinedDepositsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-quarantined-deposits [ids]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to release quarantined deposits to their receivers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to credit deposits quarantined by an inflow limit to
+their cosmos receivers, along with an initial deposit. The ids are comma separated.
+
+Example:
+$ %s tx gov submit-legacy-proposal release-quarantined-deposits 1,2,3 --title="Release deposits" --description="Checked" --deposit=1000stake --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitQuarantinedDepositsProposal(cmd, args[0], func(title, description string, ids []uint64) govtypes.Content {
+				return types.NewReleaseQuarantinedDepositsProposal(title, description, ids)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func CmdSubmitReturnQuarantinedDepositsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "return-quarantined-deposits [ids]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to return quarantined deposits to their Ethereum senders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to send deposits quarantined by an inflow limit back to
+their Ethereum senders, along with an initial deposit. The ids are comma separated.
+
+Example:
+$ %s tx gov submit-legacy-proposal return-quarantined-deposits 1,2,3 --title="Return deposits" --description="Exploit" --deposit=1000stake --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitQuarantinedDepositsProposal(cmd, args[0], func(title, description string, ids []uint64) govtypes.Content {
+				return types.NewReturnQuarantinedDepositsProposal(title, description, ids)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func CmdSubmitAddEthereumDenylistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-ethereum-denylist [addresses]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to deny withdrawals to ethereum addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to deny withdrawals to ethereum addresses, along with an
+initial deposit. Pooled transfers to these addresses are refunded instead of batched.
+The addresses are comma separated.
+
+Example:
+$ %s tx gov submit-legacy-proposal add-ethereum-denylist 0x...,0x... --title="Denylist" --description="Sanctioned" --deposit=1000stake --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitEthereumDenylistProposal(cmd, args[0], func(title, description string, addresses []string) govtypes.Content {
+				return types.NewAddEthereumDenylistProposal(title, description, addresses)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func CmdSubmitRemoveEthereumDenylistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-ethereum-denylist [addresses]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to allow withdrawals to denylisted ethereum addresses again",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to remove ethereum addresses from the denylist, along with
+an initial deposit. The addresses are comma separated.
+
+Example:
+$ %s tx gov submit-legacy-proposal remove-ethereum-denylist 0x...,0x... --title="Denylist" --description="Delisted" --deposit=1000stake --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitEthereumDenylistProposal(cmd, args[0], func(title, description string, addresses []string) govtypes.Content {
+				return types.NewRemoveEthereumDenylistProposal(title, description, addresses)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func submitEthereumDenylistProposal(cmd *cobra.Command, addressesArg string, newContent func(title, description string, addresses []string) govtypes.Content) error {
+	var addresses []string
+	for _, s := range strings.Split(addressesArg, ",") {
+		address := strings.TrimSpace(s)
+		if !common.IsHexAddress(address) {
+			return fmt.Errorf("%s not a valid ethereum address, please input a valid ethereum address", address)
+		}
+		addresses = append(addresses, address)
+	}
+
+	title, description, err := readProposalTitleAndDescription(cmd)
+	if err != nil {
+		return err
+	}
+
+	return submitProposal(cmd, newContent(title, description, addresses))
+}
+
+func submitQuarantinedDepositsProposal(cmd *cobra.Command, idsArg string, newContent func(title, description string, ids []uint64) govtypes.Content) error {
+	var ids []uint64
+	for _, s := range strings.Split(idsArg, ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return fmt.Errorf("id %s not a valid uint, please input a valid id", s)
+		}
+		ids = append(ids, id)
+	}
+
+	title, description, err := readProposalTitleAndDescription(cmd)
+	if err != nil {
+		return err
+	}
+
+	return submitProposal(cmd, newContent(title, description, ids))
+}
+
+// addProposalFlags adds the flags shared by the proposal commands that don't read a proposal file
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+func readProposalTitleAndDescription(cmd *cobra.Command) (string, string, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", err
+	}
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", err
+	}
+	return title, description, nil
+}
+
+// submitProposal validates a proposal content and submits it with the deposit flag
+func submitProposal(cmd *cobra.Command, content govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := content.ValidateBasic(); err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -8,4 +8,10 @@ import (
 // ProposalHandler is the community Ethereum spend proposal handler.
 var (
 	ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumSpendProposal)
+
+	ReleaseQuarantinedDepositsProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitReleaseQuarantinedDepositsProposal)
+	ReturnQuarantinedDepositsProposalHandler  = govclient.NewProposalHandler(cli.CmdSubmitReturnQuarantinedDepositsProposal)
+
+	AddEthereumDenylistProposalHandler    = govclient.NewProposalHandler(cli.CmdSubmitAddEthereumDenylistProposal)
+	RemoveEthereumDenylistProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitRemoveEthereumDenylistProposal)
 )
@@ -25,6 +25,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.CancelSendToEthereum(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgIncreaseBridgeFee:
+			res, err := msgServer.IncreaseBridgeFee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgRequestBatchTx:
 			res, err := msgServer.RequestBatchTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -45,6 +49,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SubmitEthereumHeightVote(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSubmitBadSignatureEvidence:
+			res, err := msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -56,6 +64,14 @@ func NewCommunityPoolEthereumSpendProposalHandler(k keeper.Keeper) govtypes.Hand
 		switch c := content.(type) {
 		case *types.CommunityPoolEthereumSpendProposal:
 			return k.HandleCommunityPoolEthereumSpendProposal(ctx, c)
+		case *types.ReleaseQuarantinedDepositsProposal:
+			return k.HandleReleaseQuarantinedDepositsProposal(ctx, c)
+		case *types.ReturnQuarantinedDepositsProposal:
+			return k.HandleReturnQuarantinedDepositsProposal(ctx, c)
+		case *types.AddEthereumDenylistProposal:
+			return k.HandleAddEthereumDenylistProposal(ctx, c)
+		case *types.RemoveEthereumDenylistProposal:
+			return k.HandleRemoveEthereumDenylistProposal(ctx, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
 		}
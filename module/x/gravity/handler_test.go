@@ -385,3 +385,25 @@ func TestMsgSetDelegateAddresses(t *testing.T) {
 	_, err = k.DelegateKeysByValidator(wctx, &types.DelegateKeysByValidatorRequest{ValidatorAddress: valAddress.String()})
 	require.NoError(t, err)
 }
+
+func TestMsgSubmitBadSignatureEvidence(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	h := gravity.NewHandler(input.GravityKeeper)
+
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	forged := &types.BatchTx{BatchNonce: 100, Timeout: 1000, TokenContract: keeper.TokenContractAddrs[0]}
+	signature, err := types.NewEthereumSignature(forged.GetCheckpoint([]byte(input.GravityKeeper.GetParams(ctx).GravityId)), ethPrivKey)
+	require.NoError(t, err)
+	subject, err := types.PackOutgoingTx(forged)
+	require.NoError(t, err)
+
+	// the evidence reaches the keeper, which finds no validator for the signer
+	_, err = h(ctx, &types.MsgSubmitBadSignatureEvidence{
+		Subject:        subject,
+		Signature:      signature,
+		EthereumSigner: crypto.PubkeyToAddress(ethPrivKey.PublicKey).Hex(),
+		Sender:         keeper.AccAddrs[0].String(),
+	})
+	require.ErrorIs(t, err, types.ErrBadSignatureEvidence)
+}
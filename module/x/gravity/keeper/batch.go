@@ -16,7 +16,7 @@ import (
 // - find bridged denominator for given voucher type
 // - determine if a an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //   have a higher total fees. If not exit withtout creating a batch
-// - select available transactions from the outgoing transaction pool sorted by fee desc
+// - select available transactions from the outgoing transaction pool in the order of the batch selection strategy
 // - persist an outgoing batch object with an incrementing ID = nonce
 // - emit an event
 func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, maxElements int) *types.BatchTx {
@@ -24,18 +24,66 @@ func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, ma
 		return nil
 	}
 	// if there is a more profitable batch for this token type do not create a new batch
-	if lastBatch := k.getLastOutgoingBatchByTokenType(ctx, contractAddress); lastBatch != nil {
-		if lastBatch.GetFees().GTE(k.getBatchFeesByTokenType(ctx, contractAddress, maxElements)) {
-			return nil
-		}
+	if k.moreProfitableBatchExists(ctx, contractAddress, maxElements) {
+		return nil
+	}
+	return k.buildBatchTx(ctx, contractAddress, maxElements)
+}
+
+// moreProfitableBatchExists returns whether the last outstanding batch of a token pays at least
+// the fees of the next batch
+func (k Keeper) moreProfitableBatchExists(ctx sdk.Context, contractAddress common.Address, maxElements int) bool {
+	lastBatch := k.getLastOutgoingBatchByTokenType(ctx, contractAddress)
+	return lastBatch != nil && lastBatch.GetFees().GTE(k.getBatchFeesByTokenType(ctx, contractAddress, maxElements))
+}
+
+// selectBatchTxs returns the unbatched txs of a token the next batch would carry, in the order of the
+// batch selection strategy, along with the pooled txs to recipients that were denied since, and
+// whether the outflow limit of the token stopped the selection
+func (k Keeper) selectBatchTxs(ctx sdk.Context, contractAddress common.Address, maxElements int) (selected, denied []*types.SendToEthereum, limitReached bool) {
+	// stop selecting transactions once the token would go over its outflow limit
+	limit, limited := k.getOutflowLimit(ctx, contractAddress)
+	outflow := sdk.ZeroInt()
+	if limited {
+		outflow = k.GetOutflow(ctx, contractAddress, limit.Window)
 	}
 
-	var selectedStes []*types.SendToEthereum
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, contractAddress, func(ste *types.SendToEthereum) bool {
-		selectedStes = append(selectedStes, ste)
-		k.deleteUnbatchedSendToEthereum(ctx, ste.Id, ste.Erc20Fee)
-		return len(selectedStes) == maxElements
+	k.iterateBatchCandidates(ctx, contractAddress, maxElements, func(ste *types.SendToEthereum) (bool, bool) {
+		// transfers pooled before their recipient was denied are refunded instead
+		if k.checkEthereumRecipient(ctx, common.HexToAddress(ste.EthereumRecipient)) != nil {
+			denied = append(denied, ste)
+			return false, false
+		}
+		outflow = outflow.Add(ste.Erc20Token.Amount).Add(ste.Erc20Fee.Amount)
+		if limited && outflow.GT(limit.Limit) {
+			limitReached = true
+			return false, true
+		}
+		selected = append(selected, ste)
+		return true, false
 	})
+	return selected, denied, limitReached
+}
+
+// buildBatchTx selects the transactions of the next batch of a token and persists the batch
+func (k Keeper) buildBatchTx(ctx sdk.Context, contractAddress common.Address, maxElements int) *types.BatchTx {
+	selectedStes, deniedStes, limitReached := k.selectBatchTxs(ctx, contractAddress, maxElements)
+
+	batchOutflow := sdk.ZeroInt()
+	for _, ste := range selectedStes {
+		batchOutflow = batchOutflow.Add(ste.Erc20Token.Amount).Add(ste.Erc20Fee.Amount)
+		k.deleteUnbatchedSendToEthereum(ctx, ste)
+	}
+	for _, ste := range deniedStes {
+		k.RefundStaleSendToEthereum(ctx, ste, types.RefundReasonDeniedRecipient)
+	}
+
+	limit, limited := k.getOutflowLimit(ctx, contractAddress)
+	if limitReached {
+		if _, paused := k.GetOutflowPausedUntil(ctx, contractAddress); !paused {
+			k.pauseOutflow(ctx, limit, k.GetOutflow(ctx, contractAddress, limit.Window).Add(batchOutflow))
+		}
+	}
 
 	// do not create batches that would contain no transactions, even if they are requested
 	if len(selectedStes) == 0 {
@@ -44,13 +92,24 @@ func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, ma
 
 	batch := &types.BatchTx{
 		BatchNonce:    k.incrementLastOutgoingBatchNonce(ctx),
-		Timeout:       k.getTimeoutHeight(ctx),
+		Timeout:       k.getTimeoutHeight(ctx, k.GetBatchSettings(ctx, contractAddress).TargetEthTxTimeout),
 		Transactions:  selectedStes,
 		TokenContract: contractAddress.Hex(),
 		Height:        uint64(ctx.BlockHeight()),
 	}
 	k.SetOutgoingTx(ctx, batch)
 
+	for _, ste := range selectedStes {
+		k.updateSendToEthereumStatus(ctx, ste.Id, func(status *types.SendToEthereumStatus) {
+			status.State = types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_BATCHED
+			status.BatchNonce = batch.BatchNonce
+		})
+	}
+
+	if limited {
+		k.addOutflow(ctx, contractAddress, batch.Height, batchOutflow, limit.Window)
+	}
+
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeOutgoingBatch,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -63,9 +122,37 @@ func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, ma
 	return batch
 }
 
+// GetBatchSettings returns the batch settings in effect for a token, the global params with
+// the overrides of the token applied
+func (k Keeper) GetBatchSettings(ctx sdk.Context, tokenContract common.Address) types.BatchSettings {
+	params := k.GetParams(ctx)
+	settings := types.BatchSettings{
+		TokenContract:       tokenContract.Hex(),
+		BatchMaxElement:     params.BatchMaxElement,
+		TargetEthTxTimeout:  params.TargetEthTxTimeout,
+		BatchCreationPeriod: params.BatchCreationPeriod,
+	}
+	for _, override := range params.BatchSettingsOverrides {
+		if common.HexToAddress(override.TokenContract) != tokenContract {
+			continue
+		}
+		if override.BatchMaxElement != 0 {
+			settings.BatchMaxElement = override.BatchMaxElement
+		}
+		if override.TargetEthTxTimeout != 0 {
+			settings.TargetEthTxTimeout = override.TargetEthTxTimeout
+		}
+		if override.BatchCreationPeriod != 0 {
+			settings.BatchCreationPeriod = override.BatchCreationPeriod
+		}
+		break
+	}
+	return settings
+}
+
 // batchTxExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
 // It deletes all the transactions in the batch, then cancels all earlier batches
-func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, nonce uint64) error {
+func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, nonce uint64, ethereumHeight uint64) error {
 	otx := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, nonce))
 	if otx == nil {
 		k.Logger(ctx).Error("Failed to clean batches",
@@ -76,14 +163,17 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, n
 		return sdkerrors.Wrapf(types.ErrBatchExecutedError, "cannot find batch, potentially double spend may happen")
 	}
 	batchTx, _ := otx.(*types.BatchTx)
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
-		// If the iterated batches nonce is lower than the one that was just executed, cancel it
+
+	// cancel the batches of the token with a nonce lower than the one that was just executed
+	var earlierBatches []*types.BatchTx
+	k.iterateOutgoingTxIndex(ctx, types.MakeOutgoingBatchTxByTokenPrefix(tokenContract), types.MakeOutgoingBatchTxByTokenKey(tokenContract, nonce), false, func(otx types.OutgoingTx) bool {
 		btx, _ := otx.(*types.BatchTx)
-		if (btx.BatchNonce < batchTx.BatchNonce) && (btx.TokenContract == batchTx.TokenContract) {
-			k.CancelBatchTx(ctx, btx)
-		}
+		earlierBatches = append(earlierBatches, btx)
 		return false
 	})
+	for _, btx := range earlierBatches {
+		k.CancelBatchTx(ctx, btx)
+	}
 
 	// burn the amount for non cosmos originated asset
 	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(batchTx.TokenContract))
@@ -102,6 +192,16 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, n
 		}
 	}
 
+	for _, tx := range batchTx.Transactions {
+		k.deleteSendToEthereumRecords(ctx, tx.Id)
+		k.updateSendToEthereumStatus(ctx, tx.Id, func(status *types.SendToEthereumStatus) {
+			status.State = types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_EXECUTED
+			status.BatchNonce = batchTx.BatchNonce
+			status.EthereumHeight = ethereumHeight
+		})
+	}
+
+	k.recordExecutedBatchStats(ctx, batchTx)
 	k.DeleteOutgoingTx(ctx, batchTx.GetStoreIndex())
 	return nil
 }
@@ -112,13 +212,10 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, n
 // a new batch
 func (k Keeper) getBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
 	feeAmount := sdk.ZeroInt()
-	i := 0
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(tx *types.SendToEthereum) bool {
+	selected, _, _ := k.selectBatchTxs(ctx, tokenContractAddr, maxElements)
+	for _, tx := range selected {
 		feeAmount = feeAmount.Add(tx.Erc20Fee.Amount)
-		i++
-		return i == maxElements
-	})
-
+	}
 	return feeAmount
 }
 
@@ -128,20 +225,69 @@ func (k Keeper) getBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr commo
 // a new batch
 func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
 	feeAmount := sdk.ZeroInt()
-	i := 0
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(tx *types.SendToEthereum) bool {
+	selected, _, _ := k.selectBatchTxs(ctx, tokenContractAddr, maxElements)
+	for _, tx := range selected {
 		feeAmount = feeAmount.Add(tx.Erc20Fee.Amount)
-		i++
-		return i == maxElements
-	})
+	}
 	return feeAmount
 }
 
+// iterateBatchCandidates iterates over the unbatched txs of a token in the order the batch
+// selection strategy picks them. cb returns whether it selected the tx and whether to stop;
+// the iteration also stops once maxElements txs were selected
+func (k Keeper) iterateBatchCandidates(ctx sdk.Context, contract common.Address, maxElements int, cb func(*types.SendToEthereum) (bool, bool)) {
+	selected := 0
+	stopped := false
+	visit := func(ste *types.SendToEthereum) bool {
+		ok, stop := cb(ste)
+		if ok {
+			selected++
+		}
+		stopped = stop
+		return stop || selected == maxElements
+	}
+
+	params := k.GetParams(ctx)
+	switch params.BatchSelectionStrategy {
+	case types.BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_FIFO:
+		k.iterateUnbatchedSendToEthereumsByAge(ctx, contract, visit)
+	case types.BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_HYBRID:
+		// the oldest txs fill the reserved slots, the fees decide the rest
+		reserved := params.BatchOldestShare.MulInt64(int64(maxElements)).TruncateInt().Int64()
+		seen := map[uint64]bool{}
+		if reserved > 0 {
+			k.iterateUnbatchedSendToEthereumsByAge(ctx, contract, func(ste *types.SendToEthereum) bool {
+				seen[ste.Id] = true
+				return visit(ste) || int64(selected) == reserved
+			})
+		}
+		if stopped || selected == maxElements {
+			return
+		}
+		k.iterateUnbatchedSendToEthereumsByContract(ctx, contract, func(ste *types.SendToEthereum) bool {
+			if seen[ste.Id] {
+				return false
+			}
+			return visit(ste)
+		})
+	default:
+		k.iterateUnbatchedSendToEthereumsByContract(ctx, contract, visit)
+	}
+}
+
 // CancelBatchTx releases all TX in the batch and deletes the batch
 func (k Keeper) CancelBatchTx(ctx sdk.Context, batch *types.BatchTx) {
 	// free transactions from batch and reindex them
+	batchOutflow := sdk.ZeroInt()
 	for _, tx := range batch.Transactions {
 		k.setUnbatchedSendToEthereum(ctx, tx)
+		batchOutflow = batchOutflow.Add(tx.Erc20Token.Amount).Add(tx.Erc20Fee.Amount)
+	}
+
+	// the transactions did not leave, so they no longer count against the outflow limit
+	tokenContract := common.HexToAddress(batch.TokenContract)
+	if limit, found := k.getOutflowLimit(ctx, tokenContract); found && batch.Height >= windowStart(ctx, limit.Window) {
+		k.addOutflow(ctx, tokenContract, batch.Height, batchOutflow.Neg(), limit.Window)
 	}
 
 	// Delete batch since it is finished
@@ -162,14 +308,9 @@ func (k Keeper) CancelBatchTx(ctx sdk.Context, batch *types.BatchTx) {
 // getLastOutgoingBatchByTokenType gets the latest outgoing tx batch by token type
 func (k Keeper) getLastOutgoingBatchByTokenType(ctx sdk.Context, token common.Address) *types.BatchTx {
 	var lastBatch *types.BatchTx = nil
-	lastNonce := uint64(0)
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
-		btx, _ := otx.(*types.BatchTx)
-		if common.HexToAddress(btx.TokenContract) == token && btx.BatchNonce > lastNonce {
-			lastBatch = btx
-			lastNonce = btx.BatchNonce
-		}
-		return false
+	k.iterateOutgoingTxIndex(ctx, types.MakeOutgoingBatchTxByTokenPrefix(token), sdk.PrefixEndBytes(types.MakeOutgoingBatchTxByTokenPrefix(token)), true, func(otx types.OutgoingTx) bool {
+		lastBatch, _ = otx.(*types.BatchTx)
+		return true
 	})
 	return lastBatch
 }
@@ -188,12 +329,17 @@ func (k Keeper) GetLastSlashedOutgoingTxBlockHeight(ctx sdk.Context) uint64 {
 	}
 }
 
+// GetUnSlashedOutgoingTxs returns the outgoing txs created after the last slashed outgoing tx
+// block height and before maxHeight, oldest first
 func (k Keeper) GetUnSlashedOutgoingTxs(ctx sdk.Context, maxHeight uint64) (out []types.OutgoingTx) {
 	lastSlashed := k.GetLastSlashedOutgoingTxBlockHeight(ctx)
-	k.iterateOutgoingTxs(ctx, func(key []byte, otx types.OutgoingTx) bool {
-		if (otx.GetCosmosHeight() < maxHeight) && (otx.GetCosmosHeight() > lastSlashed) {
-			out = append(out, otx)
-		}
+	if maxHeight <= lastSlashed+1 {
+		return nil
+	}
+	start := types.MakeOutgoingTxByHeightKey(lastSlashed+1, nil)
+	end := types.MakeOutgoingTxByHeightKey(maxHeight, nil)
+	k.iterateOutgoingTxIndex(ctx, start, end, false, func(otx types.OutgoingTx) bool {
+		out = append(out, otx)
 		return false
 	})
 	return
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// bridgeFeePercentiles are the percentiles of recent batch fees suggested as bridge fees
+var bridgeFeePercentiles = []uint32{25, 50, 75, 90}
+
+// recordExecutedBatchStats keeps the stats of an executed batch, dropping the oldest stats of
+// its token beyond the ExecutedBatchStatsWindow
+func (k Keeper) recordExecutedBatchStats(ctx sdk.Context, batchTx *types.BatchTx) {
+	window := k.GetParams(ctx).ExecutedBatchStatsWindow
+	if window == 0 {
+		return
+	}
+
+	tokenContract := common.HexToAddress(batchTx.TokenContract)
+	stats := &types.ExecutedBatchStats{
+		TokenContract:  tokenContract.Hex(),
+		BatchNonce:     batchTx.BatchNonce,
+		ElementCount:   uint64(len(batchTx.Transactions)),
+		CreatedHeight:  batchTx.Height,
+		ExecutedHeight: uint64(ctx.BlockHeight()),
+		MinFee:         sdk.ZeroInt(),
+		MedianFee:      sdk.ZeroInt(),
+		MaxFee:         sdk.ZeroInt(),
+	}
+	if n := len(batchTx.Transactions); n > 0 {
+		fees := make([]sdk.Int, n)
+		for i, tx := range batchTx.Transactions {
+			fees[i] = tx.Erc20Fee.Amount
+		}
+		sort.Slice(fees, func(i, j int) bool { return fees[i].LT(fees[j]) })
+		stats.MinFee = fees[0]
+		stats.MaxFee = fees[n-1]
+		stats.MedianFee = fees[n/2]
+		if n%2 == 0 {
+			stats.MedianFee = fees[n/2-1].Add(fees[n/2]).QuoRaw(2)
+		}
+	}
+	k.setExecutedBatchStats(ctx, stats)
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.MakeExecutedBatchStatsPrefix(tokenContract))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for i := 0; uint64(len(keys)-i) > window; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+func (k Keeper) setExecutedBatchStats(ctx sdk.Context, stats *types.ExecutedBatchStats) {
+	key := types.MakeExecutedBatchStatsKey(common.HexToAddress(stats.TokenContract), stats.BatchNonce)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(stats))
+}
+
+// GetExecutedBatchStats returns the stats of the recently executed batches of a token, oldest first
+func (k Keeper) GetExecutedBatchStats(ctx sdk.Context, tokenContract common.Address) []*types.ExecutedBatchStats {
+	var out []*types.ExecutedBatchStats
+	k.iterateExecutedBatchStats(ctx, types.MakeExecutedBatchStatsPrefix(tokenContract), func(stats *types.ExecutedBatchStats) bool {
+		out = append(out, stats)
+		return false
+	})
+	return out
+}
+
+// IterateExecutedBatchStats iterates over the executed batch stats of every token
+func (k Keeper) IterateExecutedBatchStats(ctx sdk.Context, cb func(*types.ExecutedBatchStats) bool) {
+	k.iterateExecutedBatchStats(ctx, []byte{types.ExecutedBatchStatsKey}, cb)
+}
+
+func (k Keeper) iterateExecutedBatchStats(ctx sdk.Context, prefix []byte, cb func(*types.ExecutedBatchStats) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stats types.ExecutedBatchStats
+		k.cdc.MustUnmarshal(iter.Value(), &stats)
+		if cb(&stats) {
+			break
+		}
+	}
+}
+
+// estimateBatchTiming returns the average number of blocks between the creation of the recently
+// executed batches of a token and the average number of blocks they took to execute. Without
+// enough history the batch creation period of the token is assumed
+func (k Keeper) estimateBatchTiming(ctx sdk.Context, tokenContract common.Address) (interval uint64, latency uint64, samples int) {
+	stats := k.GetExecutedBatchStats(ctx, tokenContract)
+	samples = len(stats)
+
+	interval = k.GetBatchSettings(ctx, tokenContract).BatchCreationPeriod
+	if samples >= 2 {
+		interval = (stats[samples-1].CreatedHeight - stats[0].CreatedHeight) / uint64(samples-1)
+	}
+
+	if samples > 0 {
+		total := uint64(0)
+		for _, s := range stats {
+			total += s.ExecutedHeight - s.CreatedHeight
+		}
+		latency = total / uint64(samples)
+	}
+	return interval, latency, samples
+}
+
+// suggestBridgeFees returns the bridgeFeePercentiles of the lowest fees included in the recently
+// executed batches of a token, considering only the batches executed within targetBlocks of their
+// creation unless targetBlocks is zero, and the number of batches considered
+func (k Keeper) suggestBridgeFees(ctx sdk.Context, tokenContract common.Address, targetBlocks uint64) ([]types.BridgeFeeSuggestion, int) {
+	var minFees []sdk.Int
+	for _, stats := range k.GetExecutedBatchStats(ctx, tokenContract) {
+		if targetBlocks != 0 && stats.ExecutedHeight-stats.CreatedHeight > targetBlocks {
+			continue
+		}
+		minFees = append(minFees, stats.MinFee)
+	}
+	n := len(minFees)
+	if n == 0 {
+		return nil, 0
+	}
+	sort.Slice(minFees, func(i, j int) bool { return minFees[i].LT(minFees[j]) })
+
+	suggestions := make([]types.BridgeFeeSuggestion, len(bridgeFeePercentiles))
+	for i, percentile := range bridgeFeePercentiles {
+		// nearest rank
+		rank := (int(percentile)*n + 99) / 100
+		suggestions[i] = types.BridgeFeeSuggestion{Percentile: percentile, Fee: minFees[rank-1]}
+	}
+	return suggestions, n
+}
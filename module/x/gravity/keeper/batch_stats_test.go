@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestSendToEthereumQueuePosition(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	require.NoError(t, input.AddBalanceToBank(ctx, mySender, allVouchers))
+
+	params := gk.GetParams(ctx)
+	params.BatchMaxElement = 2
+	params.ExecutedBatchStatsWindow = 2
+	gk.SetParams(ctx, params)
+
+	// three batches created every 10 blocks, of which the window keeps the last two
+	for _, heights := range [][2]int64{{10, 12}, {20, 27}, {30, 33}} {
+		ctx = ctx.WithBlockHeight(heights[0])
+		input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 1)
+		batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 2)
+		require.NotNil(t, batch)
+
+		ctx = ctx.WithBlockHeight(heights[1])
+		require.NoError(t, gk.batchTxExecuted(ctx, myTokenContractAddr, batch.BatchNonce, 0))
+	}
+	require.Equal(t, []*types.ExecutedBatchStats{
+		{TokenContract: myTokenContractAddr.Hex(), BatchNonce: 2, ElementCount: 1, CreatedHeight: 20, ExecutedHeight: 27, MinFee: sdk.NewInt(1), MedianFee: sdk.NewInt(1), MaxFee: sdk.NewInt(1)},
+		{TokenContract: myTokenContractAddr.Hex(), BatchNonce: 3, ElementCount: 1, CreatedHeight: 30, ExecutedHeight: 33, MinFee: sdk.NewInt(1), MedianFee: sdk.NewInt(1), MaxFee: sdk.NewInt(1)},
+	}, gk.GetExecutedBatchStats(ctx, myTokenContractAddr))
+
+	// ids 4 to 8, ordered by fee 8, 5, 6, 4, 7
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 2, 1, 4)
+
+	// batches are created every 10 blocks and execute 5 blocks later on average
+	res, err := gk.SendToEthereumQueuePosition(sdk.WrapSDKContext(ctx), &types.SendToEthereumQueuePositionRequest{Id: 5})
+	require.NoError(t, err)
+	require.Equal(t, &types.SendToEthereumQueuePositionResponse{
+		Rank:                2,
+		PoolSize:            5,
+		NextBatchFee:        sdk.NewInt(3),
+		EstimatedWaitBlocks: 15,
+		ExecutedBatches:     2,
+	}, res)
+
+	// the last transfer waits for three batches, or has to outbid the fee of 3 of the next one
+	res, err = gk.SendToEthereumQueuePosition(sdk.WrapSDKContext(ctx), &types.SendToEthereumQueuePositionRequest{Id: 7})
+	require.NoError(t, err)
+	require.Equal(t, &types.SendToEthereumQueuePositionResponse{
+		Rank:                5,
+		PoolSize:            5,
+		NextBatchFee:        sdk.NewInt(4),
+		EstimatedWaitBlocks: 35,
+		ExecutedBatches:     2,
+	}, res)
+
+	_, err = gk.SendToEthereumQueuePosition(sdk.WrapSDKContext(ctx), &types.SendToEthereumQueuePositionRequest{Id: 1})
+	require.Error(t, err)
+
+	// the stats are part of the exported genesis
+	genesis := ExportGenesis(ctx, gk)
+	require.Len(t, genesis.ExecutedBatchStats, 2)
+}
+
+func TestSuggestedBridgeFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	require.NoError(t, input.AddBalanceToBank(ctx, mySender, allVouchers))
+
+	params := gk.GetParams(ctx)
+	params.ExecutedBatchStatsWindow = 10
+	gk.SetParams(ctx, params)
+
+	// nothing executed yet
+	res, err := gk.SuggestedBridgeFee(sdk.WrapSDKContext(ctx), &types.SuggestedBridgeFeeRequest{TokenContract: myTokenContractAddr.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, &types.SuggestedBridgeFeeResponse{}, res)
+
+	for _, batch := range []struct {
+		fees    []uint64
+		created int64
+		latency int64
+	}{
+		{[]uint64{1, 4}, 10, 2},
+		{[]uint64{9, 5, 6}, 20, 20},
+		{[]uint64{2}, 30, 3},
+	} {
+		ctx = ctx.WithBlockHeight(batch.created)
+		input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, batch.fees...)
+		batchTx := gk.BuildBatchTx(ctx, myTokenContractAddr, 10)
+		require.NotNil(t, batchTx)
+
+		ctx = ctx.WithBlockHeight(batch.created + batch.latency)
+		require.NoError(t, gk.batchTxExecuted(ctx, myTokenContractAddr, batchTx.BatchNonce, 0))
+	}
+
+	stats := gk.GetExecutedBatchStats(ctx, myTokenContractAddr)
+	require.Len(t, stats, 3)
+	require.Equal(t, []sdk.Int{sdk.NewInt(1), sdk.NewInt(2), sdk.NewInt(4)}, []sdk.Int{stats[0].MinFee, stats[0].MedianFee, stats[0].MaxFee})
+	require.Equal(t, []sdk.Int{sdk.NewInt(5), sdk.NewInt(6), sdk.NewInt(9)}, []sdk.Int{stats[1].MinFee, stats[1].MedianFee, stats[1].MaxFee})
+
+	// every batch is considered without a target
+	res, err = gk.SuggestedBridgeFee(sdk.WrapSDKContext(ctx), &types.SuggestedBridgeFeeRequest{TokenContract: myTokenContractAddr.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, &types.SuggestedBridgeFeeResponse{
+		Suggestions: []types.BridgeFeeSuggestion{
+			{Percentile: 25, Fee: sdk.NewInt(1)},
+			{Percentile: 50, Fee: sdk.NewInt(2)},
+			{Percentile: 75, Fee: sdk.NewInt(5)},
+			{Percentile: 90, Fee: sdk.NewInt(5)},
+		},
+		Batches: 3,
+	}, res)
+
+	// the slow batch is left out when targeting inclusion within 5 blocks
+	res, err = gk.SuggestedBridgeFee(sdk.WrapSDKContext(ctx), &types.SuggestedBridgeFeeRequest{TokenContract: myTokenContractAddr.Hex(), TargetBlocks: 5})
+	require.NoError(t, err)
+	require.Equal(t, &types.SuggestedBridgeFeeResponse{
+		Suggestions: []types.BridgeFeeSuggestion{
+			{Percentile: 25, Fee: sdk.NewInt(1)},
+			{Percentile: 50, Fee: sdk.NewInt(1)},
+			{Percentile: 75, Fee: sdk.NewInt(2)},
+			{Percentile: 90, Fee: sdk.NewInt(2)},
+		},
+		Batches: 2,
+	}, res)
+
+	_, err = gk.SuggestedBridgeFee(sdk.WrapSDKContext(ctx), &types.SuggestedBridgeFeeRequest{TokenContract: "invalid"})
+	require.Error(t, err)
+}
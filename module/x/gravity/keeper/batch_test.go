@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -100,7 +103,7 @@ func TestBatches(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce)
+	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce, 0)
 
 	// check batch has been deleted
 	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, secondBatch.GetStoreIndex())
@@ -259,7 +262,7 @@ func TestBatchesFullCoins(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce)
+	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce, 0)
 
 	// check batch has been deleted
 	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, secondBatch.GetStoreIndex())
@@ -380,3 +383,282 @@ func TestEmptyBatch(t *testing.T) {
 
 	require.Nil(t, batchTx)
 }
+
+func TestBatchesOutflowLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+		)
+	)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.OutflowLimits = []types.OutflowLimit{{
+		TokenContract: myTokenContractAddr.Hex(),
+		Limit:         sdk.NewInt(210),
+		Window:        100,
+	}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// each tx sends 100+ tokens plus its fee
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 2, 1)
+
+	// only the two highest fee txs fit within the limit, the fees of the next batch leave out the rest
+	require.Equal(t, sdk.NewInt(5), input.GravityKeeper.GetBatchFeesByTokenType(ctx, myTokenContractAddr, 4))
+	batch := input.GravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 4)
+	require.NotNil(t, batch)
+	require.Equal(t, []*types.SendToEthereum{
+		types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+		types.NewSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
+	}, batch.Transactions)
+	require.Equal(t, sdk.NewInt(208), input.GravityKeeper.GetOutflow(ctx, myTokenContractAddr, 100))
+
+	// the token is paused for the length of the window
+	pausedUntil, paused := input.GravityKeeper.GetOutflowPausedUntil(ctx, myTokenContractAddr)
+	require.True(t, paused)
+	require.Equal(t, uint64(ctx.BlockHeight())+100, pausedUntil)
+
+	var reached bool
+	for _, event := range ctx.EventManager().Events() {
+		reached = reached || event.Type == types.EventTypeOutflowLimitReached
+	}
+	require.True(t, reached)
+
+	amount := types.NewERC20Token(10, myTokenContractAddr).GravityCoin()
+	fee := types.NewERC20Token(1, myTokenContractAddr).GravityCoin()
+	_, err := input.GravityKeeper.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee)
+	require.ErrorIs(t, err, types.ErrOutflowLimit)
+
+	// canceled batches no longer count against the limit
+	input.GravityKeeper.CancelBatchTx(ctx, batch)
+	require.True(t, input.GravityKeeper.GetOutflow(ctx, myTokenContractAddr, 100).IsZero())
+
+	// withdrawals resume once the window has passed
+	ctx = ctx.WithBlockHeight(int64(pausedUntil))
+	_, err = input.GravityKeeper.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee)
+	require.NoError(t, err)
+
+	// a single tx over the limit can never be batched
+	amount = types.NewERC20Token(210, myTokenContractAddr).GravityCoin()
+	_, err = input.GravityKeeper.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee)
+	require.ErrorIs(t, err, types.ErrOutflowLimit)
+}
+
+func TestBatchesDeniedRecipient(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		deniedReceiver      = common.HexToAddress("0x2d5a8D2e3EBbBd5c6b0dC3fF2A7B0d0f2a1BA5A1")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+		)
+	)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, deniedReceiver, 4)
+	balance := input.BankKeeper.GetBalance(ctx, mySender, allVouchers[0].Denom)
+
+	require.NoError(t, input.GravityKeeper.HandleAddEthereumDenylistProposal(ctx,
+		types.NewAddEthereumDenylistProposal("deny", "deny", []string{deniedReceiver.Hex()})))
+
+	// the denied transfer is refunded instead of batched, so its fee is not counted
+	require.Equal(t, sdk.NewInt(5), input.GravityKeeper.GetBatchFeesByTokenType(ctx, myTokenContractAddr, 4))
+	batch := input.GravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 4)
+	require.NotNil(t, batch)
+	require.Equal(t, []*types.SendToEthereum{
+		types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+		types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
+	}, batch.Transactions)
+	require.Empty(t, input.GravityKeeper.getUnbatchedSendToEthereums(ctx))
+	require.Equal(t, balance.Amount.AddRaw(104), input.BankKeeper.GetBalance(ctx, mySender, allVouchers[0].Denom).Amount)
+
+	var refunded bool
+	for _, event := range ctx.EventManager().Events() {
+		refunded = refunded || event.Type == types.EventTypeBridgeWithdrawRefunded
+	}
+	require.True(t, refunded)
+}
+
+func TestBatchesSelectionStrategy(t *testing.T) {
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+		)
+	)
+
+	specs := map[string]struct {
+		strategy    types.BatchSelectionStrategy
+		oldestShare sdk.Dec
+		expIDs      []uint64
+		expFees     int64
+	}{
+		"fee greedy": {
+			strategy:    types.BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_FEE_GREEDY,
+			oldestShare: sdk.NewDecWithPrec(5, 1),
+			expIDs:      []uint64{6, 5, 2},
+			expFees:     12,
+		},
+		"fifo": {
+			strategy:    types.BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_FIFO,
+			oldestShare: sdk.NewDecWithPrec(5, 1),
+			expIDs:      []uint64{1, 2, 3},
+			expFees:     7,
+		},
+		"hybrid reserves the oldest slot": {
+			strategy:    types.BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_HYBRID,
+			oldestShare: sdk.NewDecWithPrec(5, 1),
+			expIDs:      []uint64{1, 6, 5},
+			expFees:     11,
+		},
+		"hybrid without reserved slots": {
+			strategy:    types.BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_HYBRID,
+			oldestShare: sdk.ZeroDec(),
+			expIDs:      []uint64{6, 5, 2},
+			expFees:     12,
+		},
+		"hybrid reserving every slot": {
+			strategy:    types.BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_HYBRID,
+			oldestShare: sdk.OneDec(),
+			expIDs:      []uint64{1, 2, 3},
+			expFees:     7,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			input := CreateTestEnv(t)
+			ctx := input.Context
+
+			require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+			input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+			require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+			params := input.GravityKeeper.GetParams(ctx)
+			params.BatchSelectionStrategy = spec.strategy
+			params.BatchOldestShare = spec.oldestShare
+			input.GravityKeeper.SetParams(ctx, params)
+
+			input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 2, 1, 4, 5)
+
+			// the fees reported to relayers match the batch the strategy builds
+			require.Equal(t, sdk.NewInt(spec.expFees), input.GravityKeeper.GetBatchFeesByTokenType(ctx, myTokenContractAddr, 3))
+
+			batch := input.GravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 3)
+			require.NotNil(t, batch)
+			var gotIDs []uint64
+			gotFees := sdk.ZeroInt()
+			for _, ste := range batch.Transactions {
+				gotIDs = append(gotIDs, ste.Id)
+				gotFees = gotFees.Add(ste.Erc20Fee.Amount)
+			}
+			require.Equal(t, spec.expIDs, gotIDs)
+			require.Equal(t, sdk.NewInt(spec.expFees), gotFees)
+
+			// the selected txs left the pool, the others are still waiting
+			var pooled int
+			input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+				require.NotContains(t, spec.expIDs, ste.Id)
+				pooled++
+				return false
+			})
+			require.Equal(t, 3, pooled)
+		})
+	}
+}
+
+func TestBatchesSettingsOverrides(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		otherTokenContract  = common.HexToAddress("0x7D1AfA7B718fb893dB30A3aBc0Cfc608AaCfeBB0")
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+		)
+	)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.BatchSettingsOverrides = []types.BatchSettings{{
+		TokenContract:       myTokenContractAddr.Hex(),
+		BatchMaxElement:     2,
+		TargetEthTxTimeout:  150000,
+		BatchCreationPeriod: 7,
+	}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// tokens without overrides use the global params
+	require.Equal(t, types.BatchSettings{
+		TokenContract:       otherTokenContract.Hex(),
+		BatchMaxElement:     params.BatchMaxElement,
+		TargetEthTxTimeout:  params.TargetEthTxTimeout,
+		BatchCreationPeriod: params.BatchCreationPeriod,
+	}, input.GravityKeeper.GetBatchSettings(ctx, otherTokenContract))
+
+	res, err := input.GravityKeeper.BatchSettings(sdk.WrapSDKContext(ctx), &types.BatchSettingsRequest{TokenContract: myTokenContractAddr.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, params.BatchSettingsOverrides[0], res.BatchSettings)
+
+	ctx = ctx.WithBlockHeight(14)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 2, 1)
+
+	// off the overridden creation period no batch is created
+	ctx = ctx.WithBlockHeight(20)
+	require.Nil(t, input.GravityKeeper.CreateTriggeredBatchTx(ctx, myTokenContractAddr))
+
+	ctx = ctx.WithBlockHeight(21)
+	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 1000)
+	batch := input.GravityKeeper.CreateTriggeredBatchTx(ctx, myTokenContractAddr)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 2)
+	// the overridden timeout of 150s adds 10 ethereum blocks of 15s to the projected height
+	require.Equal(t, uint64(1000+10), batch.Timeout)
+}
+
+// BenchmarkGetLastOutgoingBatchByTokenType measures the lookup of the last batch of a token as the
+// number of outgoing batches of every token grows
+func BenchmarkGetLastOutgoingBatchByTokenType(b *testing.B) {
+	for _, count := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("batches=%d", count), func(b *testing.B) {
+			input := CreateTestEnv(b)
+			ctx := input.Context
+			for i := 0; i < count; i++ {
+				input.GravityKeeper.SetOutgoingTx(ctx, &types.BatchTx{
+					BatchNonce:    uint64(i + 1),
+					TokenContract: common.BigToAddress(big.NewInt(int64(i%10 + 1))).Hex(),
+					Height:        1,
+				})
+			}
+			// iterating uncommitted writes is linear in their number, unlike a committed store
+			ctx.MultiStore().GetKVStore(input.GravityStoreKey).(storetypes.Committer).Commit()
+			tokenContract := common.BigToAddress(big.NewInt(1))
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				require.NotNil(b, input.GravityKeeper.getLastOutgoingBatchByTokenType(ctx, tokenContract))
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// getBatchTrigger returns the batch trigger of a token, if governance set one
+func (k Keeper) getBatchTrigger(ctx sdk.Context, tokenContract common.Address) (types.BatchTrigger, bool) {
+	for _, trigger := range k.GetParams(ctx).BatchTriggers {
+		if common.HexToAddress(trigger.TokenContract) == tokenContract {
+			return trigger, true
+		}
+	}
+	return types.BatchTrigger{}, false
+}
+
+// getOutstandingBatchTxCount returns the number of batches of a token waiting to be executed on ethereum
+func (k Keeper) getOutstandingBatchTxCount(ctx sdk.Context, tokenContract common.Address) uint64 {
+	prefix := types.MakeOutgoingTxKey(append([]byte{types.BatchTxPrefixByte}, tokenContract.Bytes()...))
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
+
+// batchTriggerReason returns why a batch of a token should be created now, if it should
+func (k Keeper) batchTriggerReason(ctx sdk.Context, tokenContract common.Address, settings types.BatchSettings) (string, bool) {
+	onPeriod := uint64(ctx.BlockHeight())%settings.BatchCreationPeriod == 0
+
+	trigger, found := k.getBatchTrigger(ctx, tokenContract)
+	if !found {
+		return types.BatchTriggerReasonBatchCreationPeriod, onPeriod
+	}
+
+	if trigger.MaxOutstandingBatches != 0 && k.getOutstandingBatchTxCount(ctx, tokenContract) >= trigger.MaxOutstandingBatches {
+		return "", false
+	}
+
+	if trigger.MinFees.IsPositive() && k.getBatchFeesByTokenType(ctx, tokenContract, int(settings.BatchMaxElement)).GTE(trigger.MinFees) {
+		return types.BatchTriggerReasonMinFees, true
+	}
+
+	if trigger.MinPoolSize != 0 {
+		poolSize := uint64(0)
+		k.iterateUnbatchedSendToEthereumsByAge(ctx, tokenContract, func(*types.SendToEthereum) bool {
+			poolSize++
+			return poolSize == trigger.MinPoolSize
+		})
+		if poolSize == trigger.MinPoolSize {
+			return types.BatchTriggerReasonMinPoolSize, true
+		}
+	}
+
+	if trigger.MaxPoolAge != 0 {
+		oldest := uint64(ctx.BlockHeight())
+		k.iterateUnbatchedSendToEthereumsByAge(ctx, tokenContract, func(ste *types.SendToEthereum) bool {
+			if height, found := k.getSendToEthereumHeight(ctx, ste.Id); found {
+				oldest = height
+			}
+			return true
+		})
+		if uint64(ctx.BlockHeight())-oldest >= trigger.MaxPoolAge {
+			return types.BatchTriggerReasonMaxPoolAge, true
+		}
+	}
+
+	// a trigger that only caps the outstanding batches keeps the regular schedule
+	if !trigger.MinFees.IsPositive() && trigger.MinPoolSize == 0 && trigger.MaxPoolAge == 0 {
+		return types.BatchTriggerReasonBatchCreationPeriod, onPeriod
+	}
+	return "", false
+}
+
+// CreateTriggeredBatchTx creates a batch of a token if its batch trigger is met, and emits
+// the reason the batch was created
+func (k Keeper) CreateTriggeredBatchTx(ctx sdk.Context, tokenContract common.Address) *types.BatchTx {
+	settings := k.GetBatchSettings(ctx, tokenContract)
+	reason, ok := k.batchTriggerReason(ctx, tokenContract, settings)
+	if !ok {
+		return nil
+	}
+
+	batch := k.BuildBatchTx(ctx, tokenContract, int(settings.BatchMaxElement))
+	if batch == nil {
+		return nil
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBatchTriggered,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+		sdk.NewAttribute(types.AttributeKeyTokenContract, tokenContract.Hex()),
+		sdk.NewAttribute(types.AttributeKeyBatchNonce, fmt.Sprint(batch.BatchNonce)),
+		sdk.NewAttribute(types.AttributeKeyBatchTriggerReason, reason),
+	))
+
+	return batch
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// HandleOutgoingTxSignature records whether a validator expected to sign an outgoing tx missed it in
+// its bridge signing info. Once the validator missed more than BridgeSigningMaxMissedRatio of the last
+// BridgeSigningWindow outgoing txs it was expected to sign, it is slashed by the slash fraction of the
+// outgoing tx type, jailed, and its window is reset.
+func (k Keeper) HandleOutgoingTxSignature(ctx sdk.Context, validator stakingtypes.Validator, otx types.OutgoingTx, missed bool) error {
+	params := k.GetParams(ctx)
+	valAddr := validator.GetOperator()
+
+	info := k.GetBridgeSigningInfo(ctx, valAddr)
+	if info == nil {
+		info = &types.BridgeSigningInfo{
+			ValidatorAddress: valAddr.String(),
+			StartHeight:      uint64(ctx.BlockHeight()),
+		}
+	}
+
+	txType := types.OutgoingTxType_OUTGOING_TX_TYPE_UNSPECIFIED
+	if missed {
+		txType = outgoingTxType(otx)
+	}
+	recordOutgoingTxSignature(info, params.BridgeSigningWindow, txType)
+
+	if !missed || !bridgeSigningMissedRatioExceeded(info, params) || k.StakingKeeper.Validator(ctx, valAddr).IsJailed() {
+		k.setBridgeSigningInfo(ctx, info)
+		return nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return fmt.Errorf("failed to get validator consensus address: %s", err)
+	}
+	slashFraction, reason := outgoingTxPenalty(params, txType)
+	power := validator.ConsensusPower(k.PowerReduction)
+	k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, slashFraction)
+	k.StakingKeeper.Jail(ctx, consAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			slashingtypes.EventTypeSlash,
+			sdk.NewAttribute(slashingtypes.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(slashingtypes.AttributeKeyJailed, consAddr.String()),
+			sdk.NewAttribute(slashingtypes.AttributeKeyReason, reason),
+			sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
+		),
+	)
+
+	// the validator starts over with an empty window, so it is not penalised again for the same misses
+	resetBridgeSigningInfo(info)
+	k.setBridgeSigningInfo(ctx, info)
+	return nil
+}
+
+// bridgeSigningMissedRatioExceeded returns whether a validator missed more than BridgeSigningMaxMissedRatio
+// of its window. Like x/slashing, a validator is only penalised once a whole window was counted.
+func bridgeSigningMissedRatioExceeded(info *types.BridgeSigningInfo, params types.Params) bool {
+	missedTxs := info.MissedSignerSetTxs + info.MissedBatchTxs + info.MissedContractCallTxs
+	maxMissed := params.BridgeSigningMaxMissedRatio.MulInt64(int64(params.BridgeSigningWindow))
+	return info.IndexOffset >= params.BridgeSigningWindow && sdk.NewDec(int64(missedTxs)).GT(maxMissed)
+}
+
+func resetBridgeSigningInfo(info *types.BridgeSigningInfo) {
+	info.IndexOffset = 0
+	info.MissedOutgoingTxs = nil
+	info.MissedSignerSetTxs, info.MissedBatchTxs, info.MissedContractCallTxs = 0, 0, 0
+}
+
+// recordOutgoingTxSignature records the outgoing tx type missed, or unspecified if signed, at the next
+// index of the window and recounts the missed outgoing txs
+func recordOutgoingTxSignature(info *types.BridgeSigningInfo, window uint64, txType types.OutgoingTxType) {
+	resizeBridgeSigningWindow(info, window)
+	index := info.IndexOffset % window
+	for uint64(len(info.MissedOutgoingTxs)) <= index {
+		info.MissedOutgoingTxs = append(info.MissedOutgoingTxs, types.OutgoingTxType_OUTGOING_TX_TYPE_UNSPECIFIED)
+	}
+	info.MissedOutgoingTxs[index] = txType
+	info.IndexOffset++
+
+	info.MissedSignerSetTxs, info.MissedBatchTxs, info.MissedContractCallTxs = 0, 0, 0
+	for _, missed := range info.MissedOutgoingTxs {
+		switch missed {
+		case types.OutgoingTxType_OUTGOING_TX_TYPE_SIGNER_SET:
+			info.MissedSignerSetTxs++
+		case types.OutgoingTxType_OUTGOING_TX_TYPE_BATCH:
+			info.MissedBatchTxs++
+		case types.OutgoingTxType_OUTGOING_TX_TYPE_CONTRACT_CALL:
+			info.MissedContractCallTxs++
+		}
+	}
+}
+
+// resizeBridgeSigningWindow rebuilds the window of a validator in the order its outgoing txs were
+// recorded once BridgeSigningWindow changed, keeping the latest ones that fit into the new window
+func resizeBridgeSigningWindow(info *types.BridgeSigningInfo, window uint64) {
+	length := uint64(len(info.MissedOutgoingTxs))
+	// the window only differs from its length once it was filled, or when it shrank
+	if length == 0 || length == window || (length < window && info.IndexOffset <= length) {
+		return
+	}
+
+	// the slot after the last recorded one holds the oldest outgoing tx
+	oldest := info.IndexOffset % length
+	ordered := append(append([]types.OutgoingTxType{}, info.MissedOutgoingTxs[oldest:]...), info.MissedOutgoingTxs[:oldest]...)
+	if length > window {
+		ordered = ordered[length-window:]
+	}
+	info.MissedOutgoingTxs = ordered
+	info.IndexOffset = uint64(len(ordered))
+}
+
+func outgoingTxType(otx types.OutgoingTx) types.OutgoingTxType {
+	switch otx.(type) {
+	case *types.SignerSetTx:
+		return types.OutgoingTxType_OUTGOING_TX_TYPE_SIGNER_SET
+	case *types.BatchTx:
+		return types.OutgoingTxType_OUTGOING_TX_TYPE_BATCH
+	case *types.ContractCallTx:
+		return types.OutgoingTxType_OUTGOING_TX_TYPE_CONTRACT_CALL
+	default:
+		panic(fmt.Sprintf("unknown outgoing tx type %T", otx))
+	}
+}
+
+// outgoingTxPenalty returns the slash fraction and the slash event reason for missing an outgoing tx type
+func outgoingTxPenalty(params types.Params, txType types.OutgoingTxType) (sdk.Dec, string) {
+	switch txType {
+	case types.OutgoingTxType_OUTGOING_TX_TYPE_SIGNER_SET:
+		return params.SlashFractionSignerSetTx, types.AttributeMissingBridgeSignerSetSig
+	case types.OutgoingTxType_OUTGOING_TX_TYPE_CONTRACT_CALL:
+		return params.SlashFractionContractCallTx, types.AttributeMissingBridgeContractCallSig
+	default:
+		return params.SlashFractionBatch, types.AttributeMissingBridgeBatchSig
+	}
+}
+
+// GetBridgeSigningInfo returns the bridge signing info of a validator, or nil if it was never expected
+// to sign an outgoing tx
+func (k Keeper) GetBridgeSigningInfo(ctx sdk.Context, val sdk.ValAddress) *types.BridgeSigningInfo {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeBridgeSigningInfoKey(val))
+	if bz == nil {
+		return nil
+	}
+	var info types.BridgeSigningInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return &info
+}
+
+func (k Keeper) setBridgeSigningInfo(ctx sdk.Context, info *types.BridgeSigningInfo) {
+	val, err := sdk.ValAddressFromBech32(info.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.MakeBridgeSigningInfoKey(val), k.cdc.MustMarshal(info))
+}
+
+// IterateBridgeSigningInfos iterates over the bridge signing infos of all validators
+func (k Keeper) IterateBridgeSigningInfos(ctx sdk.Context, cb func(*types.BridgeSigningInfo) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.BridgeSigningInfoKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.BridgeSigningInfo
+		k.cdc.MustUnmarshal(iter.Value(), &info)
+		if cb(&info) {
+			break
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestRecordOutgoingTxSignature(t *testing.T) {
+	info := &types.BridgeSigningInfo{}
+	for _, txType := range []types.OutgoingTxType{
+		types.OutgoingTxType_OUTGOING_TX_TYPE_SIGNER_SET,
+		types.OutgoingTxType_OUTGOING_TX_TYPE_BATCH,
+		types.OutgoingTxType_OUTGOING_TX_TYPE_UNSPECIFIED,
+	} {
+		recordOutgoingTxSignature(info, 3, txType)
+	}
+	require.Equal(t, uint64(3), info.IndexOffset)
+	require.Equal(t, uint64(1), info.MissedSignerSetTxs)
+	require.Equal(t, uint64(1), info.MissedBatchTxs)
+
+	// the window slides over the oldest outgoing tx
+	recordOutgoingTxSignature(info, 3, types.OutgoingTxType_OUTGOING_TX_TYPE_CONTRACT_CALL)
+	require.Zero(t, info.MissedSignerSetTxs)
+	require.Equal(t, uint64(1), info.MissedBatchTxs)
+	require.Equal(t, uint64(1), info.MissedContractCallTxs)
+
+	// a shrunk window keeps the latest outgoing txs and slides over the oldest of them
+	recordOutgoingTxSignature(info, 2, types.OutgoingTxType_OUTGOING_TX_TYPE_BATCH)
+	require.Equal(t, []types.OutgoingTxType{
+		types.OutgoingTxType_OUTGOING_TX_TYPE_BATCH,
+		types.OutgoingTxType_OUTGOING_TX_TYPE_CONTRACT_CALL,
+	}, info.MissedOutgoingTxs)
+	require.Equal(t, uint64(3), info.IndexOffset)
+	require.Equal(t, uint64(1), info.MissedBatchTxs)
+	require.Equal(t, uint64(1), info.MissedContractCallTxs)
+
+	// a grown window keeps them all and fills up before it slides
+	recordOutgoingTxSignature(info, 4, types.OutgoingTxType_OUTGOING_TX_TYPE_SIGNER_SET)
+	require.Equal(t, []types.OutgoingTxType{
+		types.OutgoingTxType_OUTGOING_TX_TYPE_CONTRACT_CALL,
+		types.OutgoingTxType_OUTGOING_TX_TYPE_BATCH,
+		types.OutgoingTxType_OUTGOING_TX_TYPE_SIGNER_SET,
+	}, info.MissedOutgoingTxs)
+	require.Equal(t, uint64(3), info.IndexOffset)
+}
+
+func TestHandleOutgoingTxSignature(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	params := gk.GetParams(ctx)
+	params.BridgeSigningWindow = 4
+	params.BridgeSigningMaxMissedRatio = sdk.NewDecWithPrec(5, 1)
+	gk.SetParams(ctx, params)
+
+	batch := &types.BatchTx{BatchNonce: 1, TokenContract: TokenContractAddrs[0]}
+	contractCall := &types.ContractCallTx{InvalidationNonce: 1, InvalidationScope: []byte("scope")}
+	validator := func(i int) stakingtypes.Validator {
+		val, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[i])
+		require.True(t, found)
+		return val
+	}
+
+	// missing half the window is tolerated, and no one is penalised before a whole window was counted
+	for i, missed := range []bool{true, false, false, true, false} {
+		require.NoError(t, gk.HandleOutgoingTxSignature(ctx, validator(1), batch, missed))
+		require.False(t, validator(1).IsJailed(), i)
+	}
+	info := gk.GetBridgeSigningInfo(ctx, ValAddrs[1])
+	require.Equal(t, uint64(5), info.IndexOffset)
+	require.Equal(t, uint64(1), info.MissedBatchTxs)
+
+	tokensBefore := validator(0).GetTokens()
+	require.NoError(t, gk.HandleOutgoingTxSignature(ctx, validator(0), batch, true))
+	require.NoError(t, gk.HandleOutgoingTxSignature(ctx, validator(0), batch, true))
+	require.NoError(t, gk.HandleOutgoingTxSignature(ctx, validator(0), batch, false))
+	require.False(t, validator(0).IsJailed())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, gk.HandleOutgoingTxSignature(ctx, validator(0), contractCall, true))
+	require.True(t, validator(0).IsJailed())
+	require.True(t, validator(0).GetTokens().LT(tokensBefore))
+
+	var reason string
+	for _, event := range ctx.EventManager().Events() {
+		for _, attr := range event.Attributes {
+			if event.Type == slashingtypes.EventTypeSlash && attr.Key == slashingtypes.AttributeKeyReason {
+				reason = attr.Value
+			}
+		}
+	}
+	require.Equal(t, types.AttributeMissingBridgeContractCallSig, reason)
+
+	// the validator starts over with an empty window
+	info = gk.GetBridgeSigningInfo(ctx, ValAddrs[0])
+	require.Zero(t, info.IndexOffset)
+	require.Zero(t, info.MissedBatchTxs+info.MissedContractCallTxs)
+
+	res, err := gk.BridgeSigningInfos(sdk.WrapSDKContext(ctx), &types.BridgeSigningInfosRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.SigningInfos, 2)
+	_, err = gk.BridgeSigningInfos(sdk.WrapSDKContext(ctx), &types.BridgeSigningInfosRequest{ValidatorAddress: ValAddrs[2].String()})
+	require.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// SlashConflictingEventVotes slashes and jails the validators that voted for another event than the
+// accepted one at an event nonce, once they cast more conflicting votes within the grace window than
+// the grace votes allow. It must run before the event vote records at the nonce are pruned.
+func (k Keeper) SlashConflictingEventVotes(ctx sdk.Context, eventNonce uint64, records []*types.EthereumEventVoteRecord) {
+	params := k.GetParams(ctx)
+	if !params.ConflictingEthereumEventSlashing {
+		return
+	}
+
+	accepted := false
+	for _, record := range records {
+		accepted = accepted || record.Accepted
+	}
+	// nothing to compare against, e.g. records imported from genesis after the accepted one was pruned
+	if !accepted {
+		return
+	}
+
+	for _, record := range records {
+		if record.Accepted {
+			continue
+		}
+		for _, vote := range record.Votes {
+			valAddr, err := sdk.ValAddressFromBech32(vote)
+			if err != nil {
+				k.Logger(ctx).Error("SlashConflictingEventVotes: invalid validator address", "validator", vote, "error", err)
+				continue
+			}
+
+			conflictingVotes := k.recordConflictingEventVote(ctx, valAddr, eventNonce, params.ConflictingEthereumEventGraceWindow)
+			if conflictingVotes <= params.ConflictingEthereumEventGraceVotes {
+				continue
+			}
+
+			validator, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+			// Don't slash validators that are gone or already jailed
+			if !found || validator.IsUnbonded() || validator.IsJailed() {
+				continue
+			}
+			consAddr, err := validator.GetConsAddr()
+			if err != nil {
+				k.Logger(ctx).Error("SlashConflictingEventVotes: failed to get validator consensus address", "validator", vote, "error", err)
+				continue
+			}
+
+			power := validator.ConsensusPower(k.PowerReduction)
+			k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, params.SlashFractionConflictingEthereumSignature)
+			k.StakingKeeper.Jail(ctx, consAddr)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					slashingtypes.EventTypeSlash,
+					sdk.NewAttribute(slashingtypes.AttributeKeyAddress, consAddr.String()),
+					sdk.NewAttribute(slashingtypes.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(slashingtypes.AttributeKeyReason, types.AttributeConflictingEthereumEventVote),
+					sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
+				),
+			)
+		}
+	}
+}
+
+// recordConflictingEventVote records a conflicting vote of a validator, forgets its conflicting votes
+// older than the grace window, and returns the number of conflicting votes it cast within the window
+func (k Keeper) recordConflictingEventVote(ctx sdk.Context, val sdk.ValAddress, eventNonce, graceWindow uint64) uint64 {
+	height := uint64(ctx.BlockHeight())
+
+	var expired []uint64
+	count := uint64(1)
+	k.iterateConflictingEventVotes(ctx, val, func(vote *types.ConflictingEventVote) bool {
+		if vote.Height+graceWindow <= height {
+			expired = append(expired, vote.EventNonce)
+		} else if vote.EventNonce != eventNonce {
+			count++
+		}
+		return false
+	})
+	for _, nonce := range expired {
+		ctx.KVStore(k.storeKey).Delete(types.MakeConflictingEventVoteKey(val, nonce))
+	}
+
+	k.setConflictingEventVote(ctx, val, &types.ConflictingEventVote{
+		ValidatorAddress: val.String(),
+		EventNonce:       eventNonce,
+		Height:           height,
+	})
+	return count
+}
+
+func (k Keeper) setConflictingEventVote(ctx sdk.Context, val sdk.ValAddress, vote *types.ConflictingEventVote) {
+	ctx.KVStore(k.storeKey).Set(types.MakeConflictingEventVoteKey(val, vote.EventNonce), k.cdc.MustMarshal(vote))
+}
+
+func (k Keeper) iterateConflictingEventVotes(ctx sdk.Context, val sdk.ValAddress, cb func(*types.ConflictingEventVote) bool) {
+	k.iterateConflictingEventVotesByPrefix(ctx, types.MakeConflictingEventVotePrefix(val), cb)
+}
+
+// IterateAllConflictingEventVotes iterates over the conflicting event votes of all validators
+func (k Keeper) IterateAllConflictingEventVotes(ctx sdk.Context, cb func(*types.ConflictingEventVote) bool) {
+	k.iterateConflictingEventVotesByPrefix(ctx, []byte{types.ConflictingEventVoteKey}, cb)
+}
+
+func (k Keeper) iterateConflictingEventVotesByPrefix(ctx sdk.Context, prefix []byte, cb func(*types.ConflictingEventVote) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.ConflictingEventVote
+		k.cdc.MustUnmarshal(iter.Value(), &vote)
+		if cb(&vote) {
+			break
+		}
+	}
+}
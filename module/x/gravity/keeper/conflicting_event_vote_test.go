@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestRecordConflictingEventVote(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(1000)
+	gk := input.GravityKeeper
+
+	require.Equal(t, uint64(1), gk.recordConflictingEventVote(ctx, ValAddrs[0], 1, 100))
+	require.Equal(t, uint64(1), gk.recordConflictingEventVote(ctx, ValAddrs[1], 1, 100))
+
+	ctx = ctx.WithBlockHeight(1050)
+	require.Equal(t, uint64(2), gk.recordConflictingEventVote(ctx, ValAddrs[0], 2, 100))
+
+	// the first vote leaves the grace window
+	ctx = ctx.WithBlockHeight(1100)
+	require.Equal(t, uint64(2), gk.recordConflictingEventVote(ctx, ValAddrs[0], 3, 100))
+
+	var nonces []uint64
+	gk.iterateConflictingEventVotes(ctx, ValAddrs[0], func(vote *types.ConflictingEventVote) bool {
+		nonces = append(nonces, vote.EventNonce)
+		return false
+	})
+	require.Equal(t, []uint64{2, 3}, nonces)
+
+	// without a grace window only the vote itself counts
+	require.Equal(t, uint64(1), gk.recordConflictingEventVote(ctx, ValAddrs[1], 2, 0))
+}
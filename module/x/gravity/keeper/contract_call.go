@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"bytes"
 	"encoding/hex"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,15 +17,18 @@ func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte,
 	}
 
 	completedCallTx, _ := otx.(*types.ContractCallTx)
-	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
-		// If the iterated contract call's nonce is lower than the one that was just executed, delete it
-		cctx, _ := otx.(*types.ContractCallTx)
-		if (cctx.InvalidationNonce < completedCallTx.InvalidationNonce) &&
-			bytes.Equal(cctx.InvalidationScope, completedCallTx.InvalidationScope) {
-			k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
-		}
+
+	// delete the contract calls of the scope with a nonce lower than the one that was just executed
+	var earlierCalls [][]byte
+	start := types.MakeOutgoingContractCallTxByScopePrefix(invalidationScope)
+	end := types.MakeOutgoingContractCallTxByScopeKey(invalidationScope, invalidationNonce)
+	k.iterateOutgoingTxIndex(ctx, start, end, false, func(otx types.OutgoingTx) bool {
+		earlierCalls = append(earlierCalls, otx.GetStoreIndex())
 		return false
 	})
+	for _, storeIndex := range earlierCalls {
+		k.DeleteOutgoingTx(ctx, storeIndex)
+	}
 
 	k.DeleteOutgoingTx(ctx, completedCallTx.GetStoreIndex())
 }
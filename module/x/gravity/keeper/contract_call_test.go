@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"math"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -54,6 +55,18 @@ func TestContractCallTxExecuted(t *testing.T) {
 		erc20Tokens,
 	)
 
+	// a call of another scope that starts with the same bytes
+	otherScope := []byte("test-scope-other")
+	input.GravityKeeper.CreateContractCallTx(
+		ctx,
+		nonce1,
+		otherScope,
+		contract,
+		payload,
+		erc20Tokens,
+		erc20Tokens,
+	)
+
 	cctx1 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce1)).(*types.ContractCallTx)
 	assert.Equal(t, cctx1.InvalidationScope, scope)
 	assert.Equal(t, cctx1.InvalidationNonce, nonce1)
@@ -77,4 +90,14 @@ func TestContractCallTxExecuted(t *testing.T) {
 
 	assert.Nil(t, otx1)
 	assert.Nil(t, otx2)
+	assert.NotNil(t, input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(otherScope, nonce1)))
+
+	// only the call of the other scope is left in the timeout queue
+	var queued []types.OutgoingTx
+	input.GravityKeeper.IterateTimedOutOutgoingTxs(ctx, types.ContractCallTxPrefixByte, math.MaxUint64, func(otx types.OutgoingTx) bool {
+		queued = append(queued, otx)
+		return false
+	})
+	assert.Len(t, queued, 1)
+	assert.Equal(t, types.MakeContractCallTxKey(otherScope, nonce1), queued[0].GetStoreIndex())
 }
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// checkEthereumRecipient rejects withdrawals to an address governance denylisted,
+// and to addresses the funds could never be recovered from: the zero address, the
+// bridge contract and the ERC20 contracts of cosmos originated tokens
+func (k Keeper) checkEthereumRecipient(ctx sdk.Context, recipient common.Address) error {
+	switch {
+	case recipient == (common.Address{}):
+		return sdkerrors.Wrap(types.ErrDeniedEthereumRecipient, "zero address")
+	case recipient == common.HexToAddress(k.getBridgeContractAddress(ctx)):
+		return sdkerrors.Wrapf(types.ErrDeniedEthereumRecipient, "%s is the bridge contract", recipient.Hex())
+	case k.IsEthereumDenylisted(ctx, recipient):
+		return sdkerrors.Wrapf(types.ErrDeniedEthereumRecipient, "%s is denylisted", recipient.Hex())
+	}
+	if denom, found := k.getCosmosOriginatedDenom(ctx, recipient); found {
+		return sdkerrors.Wrapf(types.ErrDeniedEthereumRecipient, "%s is the ERC20 contract of %s", recipient.Hex(), denom)
+	}
+	return nil
+}
+
+// IsEthereumDenylisted returns whether governance denied withdrawals to an ethereum address
+func (k Keeper) IsEthereumDenylisted(ctx sdk.Context, address common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeEthereumDenylistKey(address))
+}
+
+func (k Keeper) setEthereumDenylisted(ctx sdk.Context, address common.Address) {
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumDenylistKey(address), []byte{1})
+}
+
+func (k Keeper) deleteEthereumDenylisted(ctx sdk.Context, address common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeEthereumDenylistKey(address))
+}
+
+// IterateEthereumDenylist iterates over the denylisted ethereum addresses
+func (k Keeper) IterateEthereumDenylist(ctx sdk.Context, cb func(common.Address) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.EthereumDenylistKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(common.BytesToAddress(iter.Key()[1:])) {
+			break
+		}
+	}
+}
+
+// updateEthereumDenylist adds or removes ethereum addresses from the denylist
+func (k Keeper) updateEthereumDenylist(ctx sdk.Context, addresses []string, denied bool) {
+	for _, address := range addresses {
+		addr := common.HexToAddress(address)
+		if denied {
+			k.setEthereumDenylisted(ctx, addr)
+		} else {
+			k.deleteEthereumDenylisted(ctx, addr)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEthereumDenylistUpdated,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+				sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+				sdk.NewAttribute(types.AttributeKeyEthereumAddress, addr.Hex()),
+				sdk.NewAttribute(types.AttributeKeyDenied, fmt.Sprint(denied)),
+			),
+		)
+	}
+}
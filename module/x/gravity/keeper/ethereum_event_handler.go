@@ -22,6 +22,32 @@ func (k Keeper) DetectMaliciousSupply(ctx sdk.Context, denom string, amount sdk.
 	return nil
 }
 
+// creditSendToCosmos sends the coins of a deposit, held by the module account, to its cosmos receiver
+func (k Keeper) creditSendToCosmos(ctx sdk.Context, event *types.SendToCosmosEvent, coins sdk.Coins) error {
+	if recipientModule, ok := k.ReceiverModuleAccounts[event.CosmosReceiver]; ok {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, coins); err != nil {
+			return err
+		}
+	} else {
+		addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
+		if k.bankKeeper.BlockedAddr(addr) {
+			// keep the minted coin in module account and return there
+			k.Logger(ctx).Info(
+				"SendToCosmos to a blocked address: ", addr.String(),
+				"event type", fmt.Sprintf("%T", event),
+				"id", types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()),
+				"nonce", fmt.Sprint(event.GetEventNonce()),
+			)
+			return nil
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return err
+		}
+	}
+	k.AfterSendToCosmosEvent(ctx, *event)
+	return nil
+}
+
 // Handle is the entry point for EthereumEvent processing
 // Return error when an irrecoverable error is detected
 func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
@@ -29,7 +55,6 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 	case *types.SendToCosmosEvent:
 		// Check if coin is Cosmos-originated asset and get denom
 		isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(event.TokenContract))
-		addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
 		coins := sdk.Coins{sdk.NewCoin(denom, event.Amount)}
 
 		if !isCosmosOriginated {
@@ -51,30 +76,15 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 			}
 		}
 
-		if recipientModule, ok := k.ReceiverModuleAccounts[event.CosmosReceiver]; ok {
-			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, coins); err != nil {
-				return err
-			}
-		} else {
-			if k.bankKeeper.BlockedAddr(addr) {
-				// keep the minted coin in module account and return there
-				k.Logger(ctx).Info(
-					"SendToCosmos to a blocked address: ", addr.String(),
-					"event type", fmt.Sprintf("%T", event),
-					"id", types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()),
-					"nonce", fmt.Sprint(event.GetEventNonce()),
-				)
-				return nil
-			}
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return err
-			}
+		// deposits over the inflow limit of their token stay in the module account
+		if k.recordInflow(ctx, event) {
+			return nil
 		}
-		k.AfterSendToCosmosEvent(ctx, *event)
-		return nil
+
+		return k.creditSendToCosmos(ctx, event, coins)
 
 	case *types.BatchExecutedEvent:
-		if err := k.batchTxExecuted(ctx, common.HexToAddress(event.TokenContract), event.BatchNonce); err != nil {
+		if err := k.batchTxExecuted(ctx, common.HexToAddress(event.TokenContract), event.BatchNonce, event.EthereumHeight); err != nil {
 			return err
 		}
 		k.AfterBatchExecutedEvent(ctx, *event)
@@ -104,9 +114,13 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 		return nil
 
 	case *types.SignerSetTxExecutedEvent:
-		// TODO here we should check the contents of the validator set against
-		// the store, if they differ we should take some action to indicate to the
-		// user that bridge highjacking has occurred
+		// a signer set the module did not create means the bridge was hijacked, in which
+		// case it is paused and the signer set is not taken as the last observed one.
+		// No error is returned so that the incident and the pause are not discarded.
+		if incident := k.checkSignerSetTxExecutedEvent(ctx, event); incident != nil {
+			k.recordSignerSetHijack(ctx, incident)
+			return nil
+		}
 		k.setLastObservedSignerSetTx(ctx, types.SignerSetTx{
 			Nonce:   event.SignerSetTxNonce,
 			Signers: event.Members,
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -57,3 +59,167 @@ func TestDeleteNonExistingBatch_ReturnError(t *testing.T) {
 	err := input.GravityKeeper.Handle(input.Context, msg)
 	require.Error(t, err)
 }
+
+func TestSendToCosmosInflowLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	tokenContract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	_, denom := gk.ERC20ToDenomLookup(ctx, gethcommon.HexToAddress(tokenContract))
+	receiver := sdktypes.AccAddress("receiver___________")
+	ethereumSender := "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+
+	params := gk.GetParams(ctx)
+	params.InflowLimits = []types.InflowLimit{{
+		TokenContract: tokenContract,
+		Limit:         sdktypes.NewInt(100),
+		Window:        10,
+	}}
+	gk.SetParams(ctx, params)
+
+	deposit := func(nonce uint64, amount int64) error {
+		return gk.Handle(ctx, &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  tokenContract,
+			Amount:         sdktypes.NewInt(amount),
+			EthereumSender: ethereumSender,
+			CosmosReceiver: receiver.String(),
+		})
+	}
+
+	// within the limit, credited right away
+	require.NoError(t, deposit(1, 60))
+	require.Equal(t, int64(60), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	require.Equal(t, int64(60), gk.GetInflow(ctx, gethcommon.HexToAddress(tokenContract), 10).Int64())
+
+	// over the limit, quarantined
+	require.NoError(t, deposit(2, 50))
+	require.NoError(t, deposit(3, 45))
+	require.Equal(t, int64(60), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	require.Equal(t, int64(95), input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName), denom).Amount.Int64())
+
+	first := gk.GetQuarantinedDeposit(ctx, 1)
+	require.NotNil(t, first)
+	require.Equal(t, types.QuarantineStatus_QUARANTINE_STATUS_QUARANTINED, first.Status)
+	require.Equal(t, uint64(2), first.Deposit.EventNonce)
+
+	// the window moved on, the limit applies afresh
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.Equal(t, int64(0), gk.GetInflow(ctx, gethcommon.HexToAddress(tokenContract), 10).Int64())
+
+	require.NoError(t, gk.HandleReleaseQuarantinedDepositsProposal(ctx, types.NewReleaseQuarantinedDepositsProposal("release", "release", []uint64{1})))
+	require.Equal(t, int64(110), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	require.Equal(t, types.QuarantineStatus_QUARANTINE_STATUS_RELEASED, gk.GetQuarantinedDeposit(ctx, 1).Status)
+
+	// a returned deposit pays the minimum bridge fee of its token out of the deposit
+	params = gk.GetParams(ctx)
+	params.MinBridgeFees = []types.ERC20Token{types.NewERC20Token(5, gethcommon.HexToAddress(tokenContract))}
+	gk.SetParams(ctx, params)
+
+	require.NoError(t, gk.HandleReturnQuarantinedDepositsProposal(ctx, types.NewReturnQuarantinedDepositsProposal("return", "return", []uint64{2})))
+	require.Equal(t, types.QuarantineStatus_QUARANTINE_STATUS_RETURNED, gk.GetQuarantinedDeposit(ctx, 2).Status)
+
+	var returned []*types.SendToEthereum
+	gk.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		returned = append(returned, ste)
+		return false
+	})
+	require.Len(t, returned, 1)
+	require.Equal(t, ethereumSender, returned[0].EthereumRecipient)
+	require.Equal(t, int64(40), returned[0].Erc20Token.Amount.Int64())
+	require.Equal(t, int64(5), returned[0].Erc20Fee.Amount.Int64())
+	require.Equal(t, returned[0].Id, gk.GetQuarantinedDeposit(ctx, 2).SendToEthereumId)
+
+	// a returned deposit that goes stale in the pool goes back to quarantine
+	gk.RefundStaleSendToEthereum(ctx, returned[0], types.RefundReasonMaxPoolAge)
+	require.Nil(t, gk.getUnbatchedSendToEthereum(ctx, returned[0].Id))
+	require.Equal(t, types.QuarantineStatus_QUARANTINE_STATUS_QUARANTINED, gk.GetQuarantinedDeposit(ctx, 2).Status)
+	require.Equal(t, int64(45), input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName), denom).Amount.Int64())
+	require.NoError(t, gk.HandleReturnQuarantinedDepositsProposal(ctx, types.NewReturnQuarantinedDepositsProposal("return", "return", []uint64{2})))
+
+	// a deposit can only leave the quarantine once
+	require.Error(t, gk.HandleReleaseQuarantinedDepositsProposal(ctx, types.NewReleaseQuarantinedDepositsProposal("release", "release", []uint64{2})))
+	require.Error(t, gk.HandleReturnQuarantinedDepositsProposal(ctx, types.NewReturnQuarantinedDepositsProposal("return", "return", []uint64{3})))
+
+	// a deposit to a blocked address cannot be released, it stays quarantined to be returned
+	require.NoError(t, gk.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     4,
+		TokenContract:  tokenContract,
+		Amount:         sdktypes.NewInt(101),
+		EthereumSender: ethereumSender,
+		CosmosReceiver: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+	}))
+	require.ErrorIs(t, gk.HandleReleaseQuarantinedDepositsProposal(ctx, types.NewReleaseQuarantinedDepositsProposal("release", "release", []uint64{3})), types.ErrQuarantinedDeposit)
+	require.Equal(t, types.QuarantineStatus_QUARANTINE_STATUS_QUARANTINED, gk.GetQuarantinedDeposit(ctx, 3).Status)
+	require.NoError(t, gk.HandleReturnQuarantinedDepositsProposal(ctx, types.NewReturnQuarantinedDepositsProposal("return", "return", []uint64{3})))
+}
+
+func TestSignerSetTxExecutedEventHijack(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	signerSetTx := gk.CreateSignerSetTx(ctx)
+	require.Len(t, signerSetTx.Signers, 5)
+
+	// the members of the signer set that was created are observed, in any order
+	members := types.EthereumSigners{}
+	for i := len(signerSetTx.Signers) - 1; i >= 0; i-- {
+		signer := *signerSetTx.Signers[i]
+		members = append(members, &signer)
+	}
+	require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{
+		EventNonce:       1,
+		SignerSetTxNonce: signerSetTx.Nonce,
+		Members:          members,
+	}))
+	require.Equal(t, signerSetTx.Nonce, gk.GetLastObservedSignerSetTx(ctx).Nonce)
+	require.Nil(t, gk.GetSignerSetHijackIncident(ctx, signerSetTx.Nonce))
+	require.True(t, gk.GetParams(ctx).BridgeActive)
+
+	// the next signer set gives all the power to an address the module does not know of
+	nextSignerSetTx := gk.CreateSignerSetTx(ctx)
+	hijacked := types.EthereumSigners{{Power: 4294967295, EthereumAddress: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"}}
+	ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+	require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{
+		EventNonce:       2,
+		SignerSetTxNonce: nextSignerSetTx.Nonce,
+		Members:          hijacked,
+	}))
+
+	incident := gk.GetSignerSetHijackIncident(ctx, nextSignerSetTx.Nonce)
+	require.Equal(t, &types.SignerSetHijackIncident{
+		SignerSetNonce:  nextSignerSetTx.Nonce,
+		ExpectedHash:    nextSignerSetTx.Signers.Hash(),
+		ObservedHash:    hijacked.Hash(),
+		ObservedSigners: hijacked,
+		EventNonce:      2,
+		Height:          uint64(ctx.BlockHeight()),
+	}, incident)
+	require.False(t, gk.GetParams(ctx).BridgeActive)
+	require.Equal(t, signerSetTx.Nonce, gk.GetLastObservedSignerSetTx(ctx).Nonce)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeSignerSetHijacked, events[0].Type)
+
+	// a signer set the module never created is a hijack as well
+	require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{
+		EventNonce:       3,
+		SignerSetTxNonce: nextSignerSetTx.Nonce + 1,
+		Members:          hijacked,
+	}))
+	incident = gk.GetSignerSetHijackIncident(ctx, nextSignerSetTx.Nonce+1)
+	require.NotNil(t, incident)
+	require.Empty(t, incident.ExpectedHash)
+
+	res, err := gk.SignerSetHijackIncidents(sdktypes.WrapSDKContext(ctx), &types.SignerSetHijackIncidentsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Incidents, 2)
+
+	// the hashes and incidents are part of the exported genesis
+	genesis := ExportGenesis(ctx, gk)
+	require.Len(t, genesis.SignerSetHashes, 2)
+	require.Len(t, genesis.SignerSetHijackIncidents, 2)
+	require.NoError(t, genesis.ValidateBasic())
+}
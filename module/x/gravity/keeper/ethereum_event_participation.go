@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// SlashMissedEthereumEventVotes penalises the bonded validators that did not vote on an accepted event
+// within EthereumSignaturesWindow blocks of its acceptance, as EthereumEventVoteSlashingMode sets. Each
+// validator is penalised once for the events it missed so far, and the accepted heights every bonded
+// validator is past are pruned.
+func (k Keeper) SlashMissedEthereumEventVotes(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+	if !params.BridgeActive {
+		// validators cannot vote while the bridge is disabled, so the window restarts once it is enabled
+		k.setLastBridgeInactiveHeight(ctx, height)
+		return
+	}
+
+	mode := params.EthereumEventVoteSlashingMode
+	penalize := mode == types.EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_JAIL ||
+		mode == types.EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_SLASH
+
+	lastObservedEventNonce := k.GetLastObservedEventNonce(ctx)
+	lastBridgeInactiveHeight := k.getLastBridgeInactiveHeight(ctx)
+	lowestNeededNonce := lastObservedEventNonce + 1
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		participation := k.ethereumEventParticipation(ctx, validator, lastObservedEventNonce)
+		if nonce := oldestHeldEventNonce(participation); nonce < lowestNeededNonce {
+			lowestNeededNonce = nonce
+		}
+
+		// no height means no missed event
+		if !penalize || participation.OldestMissedEventHeight == 0 || validator.IsJailed() {
+			continue
+		}
+		since := participation.OldestMissedEventHeight
+		if lastBridgeInactiveHeight > since {
+			since = lastBridgeInactiveHeight
+		}
+		if height-since <= params.EthereumSignaturesWindow {
+			continue
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			k.Logger(ctx).Error("SlashMissedEthereumEventVotes: failed to get validator consensus address", "validator", validator.GetOperator().String(), "error", err)
+			continue
+		}
+		power := validator.ConsensusPower(k.PowerReduction)
+		if mode == types.EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_SLASH {
+			k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, params.SlashFractionEthereumSignature)
+		}
+		k.StakingKeeper.Jail(ctx, consAddr)
+		k.setEthereumEventExcusedNonce(ctx, validator.GetOperator(), lastObservedEventNonce)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				slashingtypes.EventTypeSlash,
+				sdk.NewAttribute(slashingtypes.AttributeKeyAddress, consAddr.String()),
+				sdk.NewAttribute(slashingtypes.AttributeKeyJailed, consAddr.String()),
+				sdk.NewAttribute(slashingtypes.AttributeKeyReason, types.AttributeMissingEthereumEventVote),
+				sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			),
+		)
+	}
+
+	k.pruneEthereumEventAcceptedHeights(ctx, lowestNeededNonce)
+}
+
+// ethereumEventParticipation returns how far behind the accepted events the votes of a validator are
+func (k Keeper) ethereumEventParticipation(ctx sdk.Context, validator stakingtypes.ValidatorI, lastObservedEventNonce uint64) *types.EthereumEventParticipation {
+	valAddr := validator.GetOperator()
+	participation := &types.EthereumEventParticipation{
+		ValidatorAddress:  valAddr.String(),
+		LastEventNonce:    k.getLastEventNonceByValidator(ctx, valAddr),
+		ExcusedEventNonce: k.getEthereumEventExcusedNonce(ctx, valAddr),
+		Jailed:            validator.IsJailed(),
+	}
+	if lastObservedEventNonce > participation.LastEventNonce {
+		participation.MissedEvents = lastObservedEventNonce - participation.LastEventNonce
+	}
+
+	if nonce := oldestHeldEventNonce(participation); nonce <= lastObservedEventNonce {
+		participation.OldestMissedEventHeight = k.getEthereumEventAcceptedHeight(ctx, nonce)
+	}
+	return participation
+}
+
+// oldestHeldEventNonce returns the oldest event nonce a validator has to vote on and is not excused from
+func oldestHeldEventNonce(participation *types.EthereumEventParticipation) uint64 {
+	if participation.ExcusedEventNonce > participation.LastEventNonce {
+		return participation.ExcusedEventNonce + 1
+	}
+	return participation.LastEventNonce + 1
+}
+
+// getEthereumEventAcceptedHeight returns the height an event nonce was accepted at, or the untracked
+// accepted height for the event nonces accepted before the heights were kept
+func (k Keeper) getEthereumEventAcceptedHeight(ctx sdk.Context, eventNonce uint64) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get(types.MakeEthereumEventAcceptedHeightKey(eventNonce)); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return k.getUntrackedEthereumEventAcceptedHeight(ctx)
+}
+
+func (k Keeper) getUntrackedEthereumEventAcceptedHeight(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.UntrackedEthereumEventAcceptedHeightKey}); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setUntrackedEthereumEventAcceptedHeight(ctx sdk.Context, height uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.UntrackedEthereumEventAcceptedHeightKey}, sdk.Uint64ToBigEndian(height))
+}
+
+func (k Keeper) setEthereumEventAcceptedHeight(ctx sdk.Context, eventNonce, height uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumEventAcceptedHeightKey(eventNonce), sdk.Uint64ToBigEndian(height))
+}
+
+// IterateEthereumEventAcceptedHeights iterates over the kept accepted heights by event nonce
+func (k Keeper) IterateEthereumEventAcceptedHeights(ctx sdk.Context, cb func(eventNonce, height uint64) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.EthereumEventAcceptedHeightKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.BigEndianToUint64(iter.Key()[1:]), sdk.BigEndianToUint64(iter.Value())) {
+			break
+		}
+	}
+}
+
+// pruneEthereumEventAcceptedHeights deletes the accepted heights of the event nonces below a nonce
+func (k Keeper) pruneEthereumEventAcceptedHeights(ctx sdk.Context, eventNonce uint64) {
+	var pruned []uint64
+	k.IterateEthereumEventAcceptedHeights(ctx, func(nonce, _ uint64) bool {
+		if nonce >= eventNonce {
+			return true
+		}
+		pruned = append(pruned, nonce)
+		return false
+	})
+	for _, nonce := range pruned {
+		ctx.KVStore(k.storeKey).Delete(types.MakeEthereumEventAcceptedHeightKey(nonce))
+	}
+}
+
+func (k Keeper) getEthereumEventExcusedNonce(ctx sdk.Context, val sdk.ValAddress) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get(types.MakeEthereumEventExcusedNonceKey(val)); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setEthereumEventExcusedNonce(ctx sdk.Context, val sdk.ValAddress, eventNonce uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumEventExcusedNonceKey(val), sdk.Uint64ToBigEndian(eventNonce))
+}
+
+// IterateEthereumEventExcusedNonces iterates over the excused event nonces of all validators
+func (k Keeper) IterateEthereumEventExcusedNonces(ctx sdk.Context, cb func(val sdk.ValAddress, eventNonce uint64) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.EthereumEventExcusedNonceKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(iter.Key()[1:]), sdk.BigEndianToUint64(iter.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getLastBridgeInactiveHeight(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastBridgeInactiveHeightKey}); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setLastBridgeInactiveHeight(ctx sdk.Context, height uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastBridgeInactiveHeightKey}, sdk.Uint64ToBigEndian(height))
+}
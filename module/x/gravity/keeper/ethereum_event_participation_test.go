@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestEthereumEventParticipation(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		gk.setLastObservedEventNonce(ctx, nonce)
+		gk.setEthereumEventAcceptedHeight(ctx, nonce, 100+nonce)
+	}
+	gk.setLastEventNonceByValidator(ctx, ValAddrs[0], 3)
+	gk.setLastEventNonceByValidator(ctx, ValAddrs[1], 1)
+
+	participation := func(i int) (missed, oldestHeight uint64) {
+		validator := input.StakingKeeper.Validator(ctx, ValAddrs[i])
+		p := gk.ethereumEventParticipation(ctx, validator, gk.GetLastObservedEventNonce(ctx))
+		return p.MissedEvents, p.OldestMissedEventHeight
+	}
+
+	missed, oldestHeight := participation(0)
+	require.Zero(t, missed)
+	require.Zero(t, oldestHeight)
+
+	missed, oldestHeight = participation(1)
+	require.Equal(t, uint64(2), missed)
+	require.Equal(t, uint64(102), oldestHeight)
+
+	// events accepted before the heights were kept are taken as accepted at the upgrade height
+	gk.setLastEventNonceByValidator(ctx, ValAddrs[3], 0)
+	ctx.KVStore(input.GravityStoreKey).Delete(types.MakeEthereumEventAcceptedHeightKey(1))
+	_, oldestHeight = participation(3)
+	require.Zero(t, oldestHeight)
+	gk.setUntrackedEthereumEventAcceptedHeight(ctx, 90)
+	_, oldestHeight = participation(3)
+	require.Equal(t, uint64(90), oldestHeight)
+
+	// a validator bonding now is not held to the events accepted before
+	require.NoError(t, gk.Hooks().AfterValidatorBonded(ctx, nil, ValAddrs[2]))
+	_, oldestHeight = participation(2)
+	require.Zero(t, oldestHeight)
+
+	// accepted heights no bonded validator is held to any more are pruned
+	gk.setLastEventNonceByValidator(ctx, ValAddrs[1], 3)
+	for _, i := range []int{2, 3, 4} {
+		gk.setEthereumEventExcusedNonce(ctx, ValAddrs[i], 2)
+	}
+	gk.SlashMissedEthereumEventVotes(ctx)
+	require.False(t, ctx.KVStore(input.GravityStoreKey).Has(types.MakeEthereumEventAcceptedHeightKey(2)))
+	require.Equal(t, uint64(103), gk.getEthereumEventAcceptedHeight(ctx, 3))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
@@ -94,6 +95,7 @@ func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.Ether
 				}
 
 				k.setLastObservedEventNonce(ctx, event.GetEventNonce())
+				k.setEthereumEventAcceptedHeight(ctx, event.GetEventNonce(), uint64(ctx.BlockHeight()))
 
 				// check that the ethereum block height recorded is lower than the attestation height
 				ethWithCosmosHeight := k.GetLastObservedEthereumBlockHeight(ctx)
@@ -221,6 +223,35 @@ func (k Keeper) iterateEthereumEventVoteRecords(ctx sdk.Context, cb func([]byte,
 	}
 }
 
+// ethereumEventVoteRecordDetails tallies the last power of the voters of a vote record and lists the
+// bonded validators that have not voted at its event nonce yet
+func (k Keeper) ethereumEventVoteRecordDetails(ctx sdk.Context, eventVoteRecord *types.EthereumEventVoteRecord, bonded []stakingtypes.Validator) (*types.EthereumEventVoteRecordDetails, error) {
+	event, err := types.UnpackEvent(eventVoteRecord.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	votePower := sdk.NewInt(0)
+	for _, validator := range eventVoteRecord.Votes {
+		val, _ := sdk.ValAddressFromBech32(validator)
+		votePower = votePower.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+	}
+
+	details := &types.EthereumEventVoteRecordDetails{
+		EventNonce: event.GetEventNonce(),
+		EventHash:  event.Hash(),
+		VoteRecord: eventVoteRecord,
+		VotePower:  votePower,
+	}
+	// validators vote on event nonces in order, so a validator past the nonce voted for some event at it
+	for _, validator := range bonded {
+		if k.getLastEventNonceByValidator(ctx, validator.GetOperator()) < details.EventNonce {
+			details.UnvotedValidators = append(details.UnvotedValidators, validator.GetOperator().String())
+		}
+	}
+	return details, nil
+}
+
 // GetLastObservedEventNonce returns the latest observed event nonce
 func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// checkBadSignatureEvidence slashes and jails the validator whose ethereum key signed over the
+// checkpoint of an outgoing tx the module never created
+func (k Keeper) checkBadSignatureEvidence(ctx sdk.Context, msg *types.MsgSubmitBadSignatureEvidence) error {
+	subject, err := types.UnpackOutgoingTx(msg.Subject)
+	if err != nil {
+		return err
+	}
+	ethereumSigner := common.HexToAddress(msg.EthereumSigner)
+
+	checkpoint := subject.GetCheckpoint([]byte(k.getGravityID(ctx)))
+	if err := types.ValidateEthereumSignature(checkpoint, msg.Signature, ethereumSigner); err != nil {
+		return sdkerrors.Wrap(types.ErrBadSignatureEvidence, err.Error())
+	}
+	if k.hasEthereumSignatureCheckpoint(ctx, checkpoint) {
+		return sdkerrors.Wrap(types.ErrBadSignatureEvidence, "the outgoing tx was created by the module")
+	}
+	if err := k.checkBadSignatureEvidenceFloor(ctx, subject); err != nil {
+		return err
+	}
+	if k.GetBadSignatureEvidence(ctx, checkpoint, ethereumSigner) != nil {
+		return sdkerrors.Wrap(types.ErrBadSignatureEvidence, "already submitted")
+	}
+
+	valAddr := k.GetOrchestratorValidatorAddress(ctx, k.GetEthereumOrchestratorAddress(ctx, ethereumSigner))
+	if valAddr.Empty() {
+		return sdkerrors.Wrapf(types.ErrBadSignatureEvidence, "no validator for ethereum signer %s", ethereumSigner.Hex())
+	}
+	validator, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrBadSignatureEvidence, "validator %s not found", valAddr)
+	}
+	if validator.IsUnbonded() {
+		return sdkerrors.Wrapf(types.ErrBadSignatureEvidence, "validator %s is unbonded", valAddr)
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	power := validator.ConsensusPower(k.PowerReduction)
+	k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, k.GetParams(ctx).SlashFractionBadEthereumSignature)
+	if !validator.IsJailed() {
+		k.StakingKeeper.Jail(ctx, consAddr)
+	}
+
+	k.setBadSignatureEvidence(ctx, &types.BadSignatureEvidence{
+		Checkpoint:       checkpoint,
+		EthereumSigner:   ethereumSigner.Hex(),
+		ValidatorAddress: valAddr.String(),
+		Height:           uint64(ctx.BlockHeight()),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			slashingtypes.EventTypeSlash,
+			sdk.NewAttribute(slashingtypes.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(slashingtypes.AttributeKeyJailed, consAddr.String()),
+			sdk.NewAttribute(slashingtypes.AttributeKeyReason, types.AttributeBadEthereumSignature),
+			sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
+		),
+	)
+	k.Logger(ctx).Info(
+		"validator slashed for signing over a checkpoint the module never created",
+		"validator", valAddr.String(),
+		"ethereum_signer", ethereumSigner.Hex(),
+		"checkpoint", hex.EncodeToString(checkpoint),
+	)
+	return nil
+}
+
+// checkBadSignatureEvidenceFloor rejects evidence over outgoing txs that may have been created
+// before the module kept their checkpoints
+func (k Keeper) checkBadSignatureEvidenceFloor(ctx sdk.Context, subject types.OutgoingTx) error {
+	floor := k.GetBadSignatureEvidenceFloor(ctx)
+	if floor == nil {
+		return nil
+	}
+
+	switch subject := subject.(type) {
+	case *types.SignerSetTx:
+		if subject.Nonce <= floor.SignerSetNonce {
+			return sdkerrors.Wrapf(types.ErrBadSignatureEvidence, "signer set %d may predate the kept checkpoints", subject.Nonce)
+		}
+	case *types.BatchTx:
+		if subject.BatchNonce <= floor.BatchNonce {
+			return sdkerrors.Wrapf(types.ErrBadSignatureEvidence, "batch %d may predate the kept checkpoints", subject.BatchNonce)
+		}
+	case *types.ContractCallTx:
+		for _, scope := range floor.ContractCallScopes {
+			if bytes.Equal(scope.InvalidationScope, subject.InvalidationScope) && subject.InvalidationNonce <= scope.InvalidationNonce {
+				return sdkerrors.Wrapf(types.ErrBadSignatureEvidence, "contract call %d may predate the kept checkpoints", subject.InvalidationNonce)
+			}
+		}
+	}
+	return nil
+}
+
+func (k Keeper) hasEthereumSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeEthereumSignatureCheckpointKey(checkpoint))
+}
+
+func (k Keeper) setEthereumSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) {
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumSignatureCheckpointKey(checkpoint), []byte{0x1})
+}
+
+// IterateEthereumSignatureCheckpoints iterates over the checkpoints of every outgoing tx created
+func (k Keeper) IterateEthereumSignatureCheckpoints(ctx sdk.Context, cb func(checkpoint []byte) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.EthereumSignatureCheckpointKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()[1:]) {
+			break
+		}
+	}
+}
+
+// GetBadSignatureEvidence returns the evidence submitted for an ethereum signer over a checkpoint
+func (k Keeper) GetBadSignatureEvidence(ctx sdk.Context, checkpoint []byte, ethereumSigner common.Address) *types.BadSignatureEvidence {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeBadSignatureEvidenceKey(checkpoint, ethereumSigner))
+	if bz == nil {
+		return nil
+	}
+	var evidence types.BadSignatureEvidence
+	k.cdc.MustUnmarshal(bz, &evidence)
+	return &evidence
+}
+
+func (k Keeper) setBadSignatureEvidence(ctx sdk.Context, evidence *types.BadSignatureEvidence) {
+	key := types.MakeBadSignatureEvidenceKey(evidence.Checkpoint, common.HexToAddress(evidence.EthereumSigner))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(evidence))
+}
+
+// IterateBadSignatureEvidence iterates over all submitted bad signature evidence
+func (k Keeper) IterateBadSignatureEvidence(ctx sdk.Context, cb func(*types.BadSignatureEvidence) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.BadSignatureEvidenceKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var evidence types.BadSignatureEvidence
+		k.cdc.MustUnmarshal(iter.Value(), &evidence)
+		if cb(&evidence) {
+			break
+		}
+	}
+}
+
+// GetBadSignatureEvidenceFloor returns the latest nonces issued before the module kept the
+// checkpoints of its outgoing txs, or nil if it kept them from genesis
+func (k Keeper) GetBadSignatureEvidenceFloor(ctx sdk.Context) *types.BadSignatureEvidenceFloor {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.BadSignatureEvidenceFloorKey})
+	if bz == nil {
+		return nil
+	}
+	var floor types.BadSignatureEvidenceFloor
+	k.cdc.MustUnmarshal(bz, &floor)
+	return &floor
+}
+
+func (k Keeper) setBadSignatureEvidenceFloor(ctx sdk.Context, floor *types.BadSignatureEvidenceFloor) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.BadSignatureEvidenceFloorKey}, k.cdc.MustMarshal(floor))
+}
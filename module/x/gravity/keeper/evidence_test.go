@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestSubmitBadSignatureEvidence(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	msgServer := NewMsgServerImpl(gk)
+
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	gk.setValidatorEthereumAddress(ctx, ValAddrs[0], ethAddr)
+	gk.setEthereumOrchestratorAddress(ctx, ethAddr, AccAddrs[0])
+
+	gravityID := []byte(gk.getGravityID(ctx))
+	evidence := func(subject types.OutgoingTx) *types.MsgSubmitBadSignatureEvidence {
+		signature, err := types.NewEthereumSignature(subject.GetCheckpoint(gravityID), ethPrivKey)
+		require.NoError(t, err)
+		any, err := types.PackOutgoingTx(subject)
+		require.NoError(t, err)
+		return &types.MsgSubmitBadSignatureEvidence{
+			Subject:        any,
+			Signature:      signature,
+			EthereumSigner: ethAddr.Hex(),
+			Sender:         AccAddrs[1].String(),
+		}
+	}
+
+	// signatures over a created signer set are never evidence
+	signerSet := gk.CreateSignerSetTx(ctx)
+	_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), evidence(signerSet))
+	require.ErrorIs(t, err, types.ErrBadSignatureEvidence)
+
+	// the signature must come from the named signer
+	forged := &types.BatchTx{
+		BatchNonce:    100,
+		Timeout:       1000,
+		TokenContract: common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5").Hex(),
+	}
+	msg := evidence(forged)
+	msg.EthereumSigner = EthAddrs[1].Hex()
+	_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrBadSignatureEvidence)
+
+	tokensBefore := input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens()
+	_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), evidence(forged))
+	require.NoError(t, err)
+
+	validator := input.StakingKeeper.Validator(ctx, ValAddrs[0])
+	require.True(t, validator.IsJailed())
+	require.True(t, validator.GetTokens().LT(tokensBefore))
+	recorded := gk.GetBadSignatureEvidence(ctx, forged.GetCheckpoint(gravityID), ethAddr)
+	require.NotNil(t, recorded)
+	require.Equal(t, ValAddrs[0].String(), recorded.ValidatorAddress)
+
+	// the same signature can only be used once
+	_, err = msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), evidence(forged))
+	require.ErrorIs(t, err, types.ErrBadSignatureEvidence)
+}
+
+func TestSubmitBadSignatureEvidenceFloor(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	gk.setEthereumOrchestratorAddress(ctx, ethAddr, AccAddrs[0])
+
+	scope := []byte("scope")
+	gk.setBadSignatureEvidenceFloor(ctx, &types.BadSignatureEvidenceFloor{
+		SignerSetNonce:     5,
+		BatchNonce:         10,
+		ContractCallScopes: []*types.ContractCallScopeFloor{{InvalidationScope: scope, InvalidationNonce: 50}},
+	})
+
+	gravityID := []byte(gk.getGravityID(ctx))
+	submit := func(subject types.OutgoingTx) error {
+		signature, err := types.NewEthereumSignature(subject.GetCheckpoint(gravityID), ethPrivKey)
+		require.NoError(t, err)
+		any, err := types.PackOutgoingTx(subject)
+		require.NoError(t, err)
+		return gk.checkBadSignatureEvidence(ctx, &types.MsgSubmitBadSignatureEvidence{
+			Subject:        any,
+			Signature:      signature,
+			EthereumSigner: ethAddr.Hex(),
+			Sender:         AccAddrs[1].String(),
+		})
+	}
+
+	// outgoing txs at or below the floor may have been created before their checkpoints were kept
+	require.ErrorIs(t, submit(&types.SignerSetTx{Nonce: 5}), types.ErrBadSignatureEvidence)
+	require.ErrorIs(t, submit(&types.BatchTx{BatchNonce: 10}), types.ErrBadSignatureEvidence)
+	require.ErrorIs(t, submit(&types.ContractCallTx{InvalidationScope: scope, InvalidationNonce: 50}), types.ErrBadSignatureEvidence)
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	require.NoError(t, submit(&types.SignerSetTx{Nonce: 6}))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+	require.NoError(t, submit(&types.ContractCallTx{InvalidationScope: scope, InvalidationNonce: 51}))
+}
+
+func TestSubmitBadSignatureEvidenceAfterGravityContractMigration(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	gk.setEthereumOrchestratorAddress(ctx, ethAddr, AccAddrs[0])
+
+	gk.setBadSignatureEvidenceFloor(ctx, &types.BadSignatureEvidenceFloor{SignerSetNonce: 5, BatchNonce: 10})
+	gk.MigrateGravityContract(ctx, "0x5e175bE4d23Fa25604CE7848F60FB340894D5CDA", 1000)
+	require.Nil(t, gk.GetBadSignatureEvidenceFloor(ctx))
+
+	// the new contract starts its signer set nonces over, a forged low nonce is evidence again
+	forged := &types.SignerSetTx{Nonce: 3}
+	signature, err := types.NewEthereumSignature(forged.GetCheckpoint([]byte(gk.getGravityID(ctx))), ethPrivKey)
+	require.NoError(t, err)
+	any, err := types.PackOutgoingTx(forged)
+	require.NoError(t, err)
+	require.NoError(t, gk.checkBadSignatureEvidence(ctx, &types.MsgSubmitBadSignatureEvidence{
+		Subject:        any,
+		Signature:      signature,
+		EthereumSigner: ethAddr.Hex(),
+		Sender:         AccAddrs[1].String(),
+	}))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+}
@@ -14,11 +14,30 @@ import (
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetParams(ctx, *data.Params)
 
+	// reset pool heights and batch timeout counts before the pool, so pooled
+	// transactions keep the height they first entered the pool at
+	for _, record := range data.SendToEthereumPoolRecords {
+		k.setSendToEthereumPoolRecord(ctx, record)
+	}
+
 	// reset pool transactions in state
 	for _, tx := range data.UnbatchedSendToEthereumTxs {
 		k.setUnbatchedSendToEthereum(ctx, tx)
 	}
 
+	// reset outflow windows and pauses in state
+	for _, outflow := range data.Outflows {
+		k.setWindowAmount(ctx, types.MakeOutflowKey, outflow)
+	}
+	for _, pause := range data.OutflowPauses {
+		k.setOutflowPausedUntil(ctx, common.HexToAddress(pause.TokenContract), pause.PausedUntil)
+	}
+
+	// reset inflow windows in state
+	for _, inflow := range data.Inflows {
+		k.setWindowAmount(ctx, types.MakeInflowKey, inflow)
+	}
+
 	// reset ethereum event vote records in state
 	for _, evr := range data.EthereumEventVoteRecords {
 		event, err := types.UnpackEvent(evr.Event)
@@ -68,6 +87,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, common.HexToAddress(item.Erc20))
 	}
 
+	// reset the last signer set change so the elapsed-block trigger keeps counting from it
+	if data.LastSignerSetChangeBlockHeight > 0 {
+		k.setLastSignerSetChangeBlockHeight(ctx, data.LastSignerSetChangeBlockHeight)
+	}
+
 	// reset outgoing txs in state
 	for _, ota := range data.OutgoingTxs {
 		otx, err := types.UnpackOutgoingTx(ota)
@@ -77,6 +101,92 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetOutgoingTx(ctx, otx)
 	}
 
+	// reset quarantined deposits in state
+	for _, deposit := range data.QuarantinedDeposits {
+		k.setQuarantinedDeposit(ctx, deposit)
+		// deposits whose send to ethereum is still in the bridge can go back to quarantine
+		if _, inBridge := k.getSendToEthereumHeight(ctx, deposit.SendToEthereumId); inBridge &&
+			deposit.Status == types.QuarantineStatus_QUARANTINE_STATUS_RETURNED {
+			ctx.KVStore(k.storeKey).Set(types.MakeReturnedQuarantinedDepositKey(deposit.SendToEthereumId), sdk.Uint64ToBigEndian(deposit.Id))
+		}
+		if deposit.Id > k.getLastQuarantinedDepositID(ctx) {
+			k.setLastQuarantinedDepositID(ctx, deposit.Id)
+		}
+	}
+
+	// reset the ethereum denylist in state
+	for _, address := range data.EthereumDenylist {
+		k.setEthereumDenylisted(ctx, common.HexToAddress(address))
+	}
+
+	// reset send to ethereum statuses in state
+	for _, status := range data.SendToEthereumStatuses {
+		k.setSendToEthereumStatus(ctx, status)
+	}
+
+	// reset executed batch stats in state
+	for _, stats := range data.ExecutedBatchStats {
+		k.setExecutedBatchStats(ctx, stats)
+	}
+
+	// reset signer set hashes and hijack incidents in state
+	for _, signerSetHash := range data.SignerSetHashes {
+		k.setSignerSetHash(ctx, signerSetHash.Nonce, signerSetHash.Hash)
+	}
+	for _, incident := range data.SignerSetHijackIncidents {
+		k.setSignerSetHijackIncident(ctx, incident)
+	}
+
+	// reset kept checkpoints and bad signature evidence in state
+	for _, checkpoint := range data.EthereumSignatureCheckpoints {
+		k.setEthereumSignatureCheckpoint(ctx, checkpoint)
+	}
+	for _, evidence := range data.BadSignatureEvidence {
+		k.setBadSignatureEvidence(ctx, evidence)
+	}
+	if data.BadSignatureEvidenceFloor != nil {
+		k.setBadSignatureEvidenceFloor(ctx, data.BadSignatureEvidenceFloor)
+	}
+
+	// reset conflicting event votes in state
+	for _, vote := range data.ConflictingEventVotes {
+		val, err := sdk.ValAddressFromBech32(vote.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setConflictingEventVote(ctx, val, vote)
+	}
+
+	// reset the event vote participation tracking in state
+	for _, accepted := range data.EthereumEventAcceptedHeights {
+		k.setEthereumEventAcceptedHeight(ctx, accepted.EventNonce, accepted.Height)
+	}
+	for _, excused := range data.EthereumEventExcusedNonces {
+		val, err := sdk.ValAddressFromBech32(excused.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setEthereumEventExcusedNonce(ctx, val, excused.EventNonce)
+	}
+	if data.LastBridgeInactiveHeight > 0 {
+		k.setLastBridgeInactiveHeight(ctx, data.LastBridgeInactiveHeight)
+	}
+	if data.UntrackedEthereumEventAcceptedHeight > 0 {
+		k.setUntrackedEthereumEventAcceptedHeight(ctx, data.UntrackedEthereumEventAcceptedHeight)
+	}
+
+	// reset bridge signing infos in state
+	for _, info := range data.BridgeSigningInfos {
+		k.setBridgeSigningInfo(ctx, info)
+	}
+	for _, val := range data.LaggingOracleValidators {
+		valAddr, err := sdk.ValAddressFromBech32(val)
+		if err != nil {
+			panic(err)
+		}
+		k.setLaggingOracleValidator(ctx, valAddr)
+	}
+
 	// reset signatures in state
 	for _, confa := range data.Confirmations {
 		conf, err := types.UnpackConfirmation(confa)
@@ -156,19 +266,145 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		return false
 	})
 
+	// export quarantined deposits
+	var quarantinedDeposits []*types.QuarantinedDeposit
+	k.IterateQuarantinedDeposits(ctx, func(deposit *types.QuarantinedDeposit) bool {
+		quarantinedDeposits = append(quarantinedDeposits, deposit)
+		return false
+	})
+
+	// export the ethereum denylist
+	var ethereumDenylist []string
+	k.IterateEthereumDenylist(ctx, func(address common.Address) bool {
+		ethereumDenylist = append(ethereumDenylist, address.Hex())
+		return false
+	})
+
+	// export send to ethereum statuses
+	var sendToEthereumStatuses []*types.SendToEthereumStatus
+	k.IterateSendToEthereumStatuses(ctx, func(status *types.SendToEthereumStatus) bool {
+		sendToEthereumStatuses = append(sendToEthereumStatuses, status)
+		return false
+	})
+
+	// export executed batch stats
+	var executedBatchStats []*types.ExecutedBatchStats
+	k.IterateExecutedBatchStats(ctx, func(stats *types.ExecutedBatchStats) bool {
+		executedBatchStats = append(executedBatchStats, stats)
+		return false
+	})
+
+	// export signer set hashes and hijack incidents
+	var signerSetHashes []*types.SignerSetHash
+	k.IterateSignerSetHashes(ctx, func(nonce uint64, hash []byte) bool {
+		signerSetHashes = append(signerSetHashes, &types.SignerSetHash{Nonce: nonce, Hash: hash})
+		return false
+	})
+	var signerSetHijackIncidents []*types.SignerSetHijackIncident
+	k.IterateSignerSetHijackIncidents(ctx, func(incident *types.SignerSetHijackIncident) bool {
+		signerSetHijackIncidents = append(signerSetHijackIncidents, incident)
+		return false
+	})
+
+	// export kept checkpoints and bad signature evidence
+	var ethereumSignatureCheckpoints [][]byte
+	k.IterateEthereumSignatureCheckpoints(ctx, func(checkpoint []byte) bool {
+		ethereumSignatureCheckpoints = append(ethereumSignatureCheckpoints, checkpoint)
+		return false
+	})
+	var badSignatureEvidence []*types.BadSignatureEvidence
+	k.IterateBadSignatureEvidence(ctx, func(evidence *types.BadSignatureEvidence) bool {
+		badSignatureEvidence = append(badSignatureEvidence, evidence)
+		return false
+	})
+
+	// export conflicting event votes
+	var conflictingEventVotes []*types.ConflictingEventVote
+	k.IterateAllConflictingEventVotes(ctx, func(vote *types.ConflictingEventVote) bool {
+		conflictingEventVotes = append(conflictingEventVotes, vote)
+		return false
+	})
+
+	// export the event vote participation tracking
+	var ethereumEventAcceptedHeights []*types.EthereumEventAcceptedHeight
+	k.IterateEthereumEventAcceptedHeights(ctx, func(eventNonce, height uint64) bool {
+		ethereumEventAcceptedHeights = append(ethereumEventAcceptedHeights, &types.EthereumEventAcceptedHeight{EventNonce: eventNonce, Height: height})
+		return false
+	})
+	var ethereumEventExcusedNonces []*types.EthereumEventExcusedNonce
+	k.IterateEthereumEventExcusedNonces(ctx, func(val sdk.ValAddress, eventNonce uint64) bool {
+		ethereumEventExcusedNonces = append(ethereumEventExcusedNonces, &types.EthereumEventExcusedNonce{ValidatorAddress: val.String(), EventNonce: eventNonce})
+		return false
+	})
+
+	var bridgeSigningInfos []*types.BridgeSigningInfo
+	k.IterateBridgeSigningInfos(ctx, func(info *types.BridgeSigningInfo) bool {
+		bridgeSigningInfos = append(bridgeSigningInfos, info)
+		return false
+	})
+	var sendToEthereumPoolRecords []*types.SendToEthereumPoolRecord
+	k.IterateSendToEthereumPoolRecords(ctx, func(record *types.SendToEthereumPoolRecord) bool {
+		sendToEthereumPoolRecords = append(sendToEthereumPoolRecords, record)
+		return false
+	})
+
+	var outflows []*types.WindowAmount
+	k.IterateOutflows(ctx, func(outflow *types.WindowAmount) bool {
+		outflows = append(outflows, outflow)
+		return false
+	})
+	var outflowPauses []*types.OutflowPause
+	k.IterateOutflowPauses(ctx, func(pause *types.OutflowPause) bool {
+		outflowPauses = append(outflowPauses, pause)
+		return false
+	})
+
+	var inflows []*types.WindowAmount
+	k.IterateInflows(ctx, func(inflow *types.WindowAmount) bool {
+		inflows = append(inflows, inflow)
+		return false
+	})
+
+	var laggingOracleValidators []string
+	k.IterateLaggingOracleValidators(ctx, func(val sdk.ValAddress) bool {
+		laggingOracleValidators = append(laggingOracleValidators, val.String())
+		return false
+	})
+
 	// this will marshal into "dW51c2Vk" as []byte will be encoded as base64
 	for _, delegate := range delegates {
 		delegate.EthSignature = []byte("unused")
 	}
 
 	return types.GenesisState{
-		Params:                     &p,
-		LastObservedEventNonce:     lastobserved,
-		OutgoingTxs:                outgoingTxs,
-		Confirmations:              ethereumTxConfirmations,
-		EthereumEventVoteRecords:   ethereumEventVoteRecords,
-		DelegateKeys:               delegates,
-		Erc20ToDenoms:              erc20ToDenoms,
-		UnbatchedSendToEthereumTxs: unbatchedTransfers,
+		Params:                               &p,
+		LastObservedEventNonce:               lastobserved,
+		OutgoingTxs:                          outgoingTxs,
+		Confirmations:                        ethereumTxConfirmations,
+		EthereumEventVoteRecords:             ethereumEventVoteRecords,
+		DelegateKeys:                         delegates,
+		Erc20ToDenoms:                        erc20ToDenoms,
+		UnbatchedSendToEthereumTxs:           unbatchedTransfers,
+		QuarantinedDeposits:                  quarantinedDeposits,
+		EthereumDenylist:                     ethereumDenylist,
+		SendToEthereumStatuses:               sendToEthereumStatuses,
+		ExecutedBatchStats:                   executedBatchStats,
+		SignerSetHashes:                      signerSetHashes,
+		SignerSetHijackIncidents:             signerSetHijackIncidents,
+		EthereumSignatureCheckpoints:         ethereumSignatureCheckpoints,
+		BadSignatureEvidence:                 badSignatureEvidence,
+		BadSignatureEvidenceFloor:            k.GetBadSignatureEvidenceFloor(ctx),
+		ConflictingEventVotes:                conflictingEventVotes,
+		EthereumEventAcceptedHeights:         ethereumEventAcceptedHeights,
+		EthereumEventExcusedNonces:           ethereumEventExcusedNonces,
+		LastBridgeInactiveHeight:             k.getLastBridgeInactiveHeight(ctx),
+		UntrackedEthereumEventAcceptedHeight: k.getUntrackedEthereumEventAcceptedHeight(ctx),
+		BridgeSigningInfos:                   bridgeSigningInfos,
+		LaggingOracleValidators:              laggingOracleValidators,
+		SendToEthereumPoolRecords:            sendToEthereumPoolRecords,
+		Outflows:                             outflows,
+		OutflowPauses:                        outflowPauses,
+		Inflows:                              inflows,
+		LastSignerSetChangeBlockHeight:       k.GetLastSignerSetChangeBlockHeight(ctx),
 	}
 }
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
 
 // for the moment this is only testing delegate keys being set, but it would be good to make
@@ -23,14 +25,56 @@ func TestExportAndImport(t *testing.T) {
 	keeper.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
 	keeper.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
 
+	evidence := &types.BadSignatureEvidence{
+		Checkpoint:       make([]byte, 32),
+		EthereumSigner:   ethAddr.Hex(),
+		ValidatorAddress: valAddr.String(),
+		Height:           1,
+	}
+	floor := &types.BadSignatureEvidenceFloor{SignerSetNonce: 2, BatchNonce: 3}
+	keeper.setBadSignatureEvidence(ctx, evidence)
+	keeper.setBadSignatureEvidenceFloor(ctx, floor)
+	keeper.setLastSignerSetChangeBlockHeight(ctx, 42)
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	send := &types.SendToEthereum{
+		Id:                1,
+		Sender:            orchAddr.String(),
+		EthereumRecipient: ethAddr.Hex(),
+		Erc20Token:        types.NewERC20Token(100, tokenContract),
+		Erc20Fee:          types.NewERC20Token(1, tokenContract),
+	}
+	keeper.setUnbatchedSendToEthereum(ctx, send)
+	keeper.IncrementSendToEthereumBatchTimeouts(ctx, send.Id)
+
+	keeper.addOutflow(ctx, tokenContract, uint64(ctx.BlockHeight()), sdk.NewInt(500), 100)
+	keeper.setOutflowPausedUntil(ctx, tokenContract, uint64(ctx.BlockHeight())+1000)
+	keeper.addToWindow(ctx, types.MakeInflowKey, tokenContract, uint64(ctx.BlockHeight()), sdk.NewInt(300), 100)
+
 	exportedGenesis := ExportGenesis(ctx, keeper)
 	newEnv := CreateTestEnv(t)
 	newCtx := newEnv.Context
 	newKeeper := newEnv.GravityKeeper
 
+	// the pool keeps the heights the transfers entered it at, not the import height
+	newCtx = newCtx.WithBlockHeight(ctx.BlockHeight() + 100)
 	InitGenesis(newCtx, newKeeper, exportedGenesis)
 
 	assert.Equal(t, newKeeper.GetValidatorEthereumAddress(newCtx, valAddr), ethAddr)
 	assert.Equal(t, newKeeper.GetEthereumOrchestratorAddress(newCtx, ethAddr), orchAddr)
 	assert.Equal(t, newKeeper.GetOrchestratorValidatorAddress(newCtx, orchAddr), valAddr)
+	assert.Equal(t, evidence, newKeeper.GetBadSignatureEvidence(newCtx, evidence.Checkpoint, ethAddr))
+	assert.Equal(t, floor, newKeeper.GetBadSignatureEvidenceFloor(newCtx))
+	assert.Equal(t, uint64(42), newKeeper.GetLastSignerSetChangeBlockHeight(newCtx))
+	height, found := newKeeper.getSendToEthereumHeight(newCtx, send.Id)
+	assert.True(t, found)
+	assert.Equal(t, uint64(ctx.BlockHeight()), height)
+	assert.Equal(t, uint64(1), newKeeper.GetSendToEthereumBatchTimeouts(newCtx, send.Id))
+
+	// a paused token stays paused and its outflow window is kept
+	pausedUntil, paused := newKeeper.GetOutflowPausedUntil(newCtx, tokenContract)
+	assert.True(t, paused)
+	assert.Equal(t, uint64(ctx.BlockHeight())+1000, pausedUntil)
+	assert.Equal(t, sdk.NewInt(500), newKeeper.GetOutflow(newCtx, tokenContract, 200))
+	assert.Equal(t, sdk.NewInt(300), newKeeper.GetInflow(newCtx, tokenContract, 200))
 }
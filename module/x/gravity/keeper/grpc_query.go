@@ -306,6 +306,40 @@ func (k Keeper) BatchTxFees(c context.Context, req *types.BatchTxFeesRequest) (*
 	return res, nil
 }
 
+func (k Keeper) SimulateBatchTx(c context.Context, req *types.SimulateBatchTxRequest) (*types.SimulateBatchTxResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token contract %s", req.TokenContract)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	tokenContract := common.HexToAddress(req.TokenContract)
+	maxElements := int(k.GetBatchSettings(ctx, tokenContract).BatchMaxElement)
+
+	res := &types.SimulateBatchTxResponse{TotalFees: sdk.ZeroInt()}
+	if maxElements == 0 {
+		return res, nil
+	}
+	res.MoreProfitableBatchExists = k.moreProfitableBatchExists(ctx, tokenContract, maxElements)
+
+	// the batch is built on a cache that is never written, leaving the pool untouched
+	cacheCtx, _ := ctx.CacheContext()
+	if batch := k.buildBatchTx(cacheCtx, tokenContract, maxElements); batch != nil {
+		res.Transactions = batch.Transactions
+		res.Timeout = batch.Timeout
+		for _, ste := range batch.Transactions {
+			res.TotalFees = res.TotalFees.Add(ste.Erc20Fee.Amount)
+		}
+	}
+	return res, nil
+}
+
+func (k Keeper) SuggestedBridgeFee(c context.Context, req *types.SuggestedBridgeFeeRequest) (*types.SuggestedBridgeFeeResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token contract %s", req.TokenContract)
+	}
+	suggestions, batches := k.suggestBridgeFees(sdk.UnwrapSDKContext(c), common.HexToAddress(req.TokenContract), req.TargetBlocks)
+	return &types.SuggestedBridgeFeeResponse{Suggestions: suggestions, Batches: uint64(batches)}, nil
+}
+
 func (k Keeper) ERC20ToDenom(c context.Context, req *types.ERC20ToDenomRequest) (*types.ERC20ToDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	cosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(req.Erc20))
@@ -403,26 +437,101 @@ func (k Keeper) BatchedSendToEthereums(c context.Context, req *types.BatchedSend
 
 func (k Keeper) UnbatchedSendToEthereums(c context.Context, req *types.UnbatchedSendToEthereumsRequest) (*types.UnbatchedSendToEthereumsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(req.SenderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	res := &types.UnbatchedSendToEthereumsResponse{}
+	res.SendToEthereums, res.Pagination, err = k.paginateUnbatchedSendToEthereumsByIndex(ctx, types.MakeSendToEthereumBySenderPrefix(sender), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
 
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SendToEthereumKey})
-	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		var ste types.SendToEthereum
-		k.cdc.MustUnmarshal(value, &ste)
-		if ste.Sender == req.SenderAddress {
-			res.SendToEthereums = append(res.SendToEthereums, &ste)
-			return true, nil
-		}
-		return false, nil
-	})
+	return res, nil
+}
+
+func (k Keeper) UnbatchedSendToEthereumsByRecipient(c context.Context, req *types.UnbatchedSendToEthereumsByRecipientRequest) (*types.UnbatchedSendToEthereumsByRecipientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !common.IsHexAddress(req.EthereumRecipient) {
+		return nil, status.Errorf(codes.InvalidArgument, "ethereum recipient %s needs to be a hex address", req.EthereumRecipient)
+	}
+
+	var err error
+	res := &types.UnbatchedSendToEthereumsByRecipientResponse{}
+	res.SendToEthereums, res.Pagination, err = k.paginateUnbatchedSendToEthereumsByIndex(ctx, types.MakeSendToEthereumByRecipientPrefix(common.HexToAddress(req.EthereumRecipient)), req.Pagination)
 	if err != nil {
 		return nil, err
 	}
-	res.Pagination = pageRes
 
 	return res, nil
 }
 
+func (k Keeper) SendToEthereumByID(c context.Context, req *types.SendToEthereumByIDRequest) (*types.SendToEthereumByIDResponse, error) {
+	send := k.getUnbatchedSendToEthereum(sdk.UnwrapSDKContext(c), req.Id)
+	if send == nil {
+		return nil, status.Errorf(codes.NotFound, "unbatched send to ethereum %d", req.Id)
+	}
+	return &types.SendToEthereumByIDResponse{SendToEthereum: send}, nil
+}
+
+func (k Keeper) SendToEthereumStatus(c context.Context, req *types.SendToEthereumStatusRequest) (*types.SendToEthereumStatusResponse, error) {
+	steStatus := k.GetSendToEthereumStatus(sdk.UnwrapSDKContext(c), req.Id)
+	if steStatus == nil {
+		return nil, status.Errorf(codes.NotFound, "send to ethereum %d", req.Id)
+	}
+	return &types.SendToEthereumStatusResponse{Status: steStatus}, nil
+}
+
+func (k Keeper) SendToEthereumQueuePosition(c context.Context, req *types.SendToEthereumQueuePositionRequest) (*types.SendToEthereumQueuePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	ste := k.getUnbatchedSendToEthereum(ctx, req.Id)
+	if ste == nil {
+		return nil, status.Errorf(codes.NotFound, "send to ethereum %d is not in the pool", req.Id)
+	}
+	tokenContract := common.HexToAddress(ste.Erc20Token.Contract)
+	batchSize := k.GetBatchSettings(ctx, tokenContract).BatchMaxElement
+
+	res := &types.SendToEthereumQueuePositionResponse{NextBatchFee: ste.Erc20Fee.Amount}
+	cutoffFee := sdk.ZeroInt()
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContract, func(other *types.SendToEthereum) bool {
+		res.PoolSize++
+		if other.Id == ste.Id {
+			res.Rank = res.PoolSize
+		}
+		if res.PoolSize == batchSize {
+			cutoffFee = other.Erc20Fee.Amount
+		}
+		return false
+	})
+
+	// outbidding the last transfer of the next batch moves the transfer into it
+	batches := uint64(1)
+	if batchSize != 0 && res.Rank > batchSize {
+		res.NextBatchFee = cutoffFee.AddRaw(1)
+		batches = (res.Rank + batchSize - 1) / batchSize
+	}
+
+	interval, latency, samples := k.estimateBatchTiming(ctx, tokenContract)
+	res.EstimatedWaitBlocks = batches*interval + latency
+	res.ExecutedBatches = uint64(samples)
+	return res, nil
+}
+
+// paginateUnbatchedSendToEthereumsByIndex paginates over the unbatched txs whose keys are
+// indexed under the given prefix
+func (k Keeper) paginateUnbatchedSendToEthereumsByIndex(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) ([]*types.SendToEthereum, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	var sends []*types.SendToEthereum
+	pageRes, err := query.Paginate(prefix.NewStore(store, indexPrefix), pagination, func(_ []byte, value []byte) error {
+		var ste types.SendToEthereum
+		k.cdc.MustUnmarshal(store.Get(value), &ste)
+		sends = append(sends, &ste)
+		return nil
+	})
+	return sends, pageRes, err
+}
+
 func (k Keeper) DelegateKeysByValidator(c context.Context, req *types.DelegateKeysByValidatorRequest) (*types.DelegateKeysByValidatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
@@ -488,3 +597,236 @@ func (k Keeper) LastObservedEthereumHeight(c context.Context, req *types.LastObs
 
 	return res, nil
 }
+
+func (k Keeper) MinBridgeFees(c context.Context, req *types.MinBridgeFeesRequest) (*types.MinBridgeFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.MinBridgeFeesResponse{MinBridgeFees: k.GetParams(ctx).MinBridgeFees}, nil
+}
+
+func (k Keeper) BatchSettings(c context.Context, req *types.BatchSettingsRequest) (*types.BatchSettingsResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token contract %s", req.TokenContract)
+	}
+	settings := k.GetBatchSettings(sdk.UnwrapSDKContext(c), common.HexToAddress(req.TokenContract))
+	return &types.BatchSettingsResponse{BatchSettings: settings}, nil
+}
+
+func (k Keeper) QuarantinedDeposits(c context.Context, req *types.QuarantinedDepositsRequest) (*types.QuarantinedDepositsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var deposits []*types.QuarantinedDeposit
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.QuarantinedDepositKey})
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var deposit types.QuarantinedDeposit
+		k.cdc.MustUnmarshal(value, &deposit)
+		if req.Status != types.QuarantineStatus_QUARANTINE_STATUS_UNSPECIFIED && deposit.Status != req.Status {
+			return false, nil
+		}
+		if accumulate {
+			deposits = append(deposits, &deposit)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuarantinedDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) QuarantinedDeposit(c context.Context, req *types.QuarantinedDepositRequest) (*types.QuarantinedDepositResponse, error) {
+	deposit := k.GetQuarantinedDeposit(sdk.UnwrapSDKContext(c), req.Id)
+	if deposit == nil {
+		return nil, status.Errorf(codes.NotFound, "quarantined deposit %d", req.Id)
+	}
+	return &types.QuarantinedDepositResponse{Deposit: deposit}, nil
+}
+
+func (k Keeper) EthereumDenylist(c context.Context, req *types.EthereumDenylistRequest) (*types.EthereumDenylistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var addresses []string
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumDenylistKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, _ []byte) error {
+		addresses = append(addresses, common.BytesToAddress(key).Hex())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EthereumDenylistResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SignerSetHijackIncidents(c context.Context, req *types.SignerSetHijackIncidentsRequest) (*types.SignerSetHijackIncidentsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var incidents []*types.SignerSetHijackIncident
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SignerSetHijackIncidentKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var incident types.SignerSetHijackIncident
+		k.cdc.MustUnmarshal(value, &incident)
+		incidents = append(incidents, &incident)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SignerSetHijackIncidentsResponse{Incidents: incidents, Pagination: pageRes}, nil
+}
+
+func (k Keeper) EthereumEventParticipation(c context.Context, req *types.EthereumEventParticipationRequest) (*types.EthereumEventParticipationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	lastObservedEventNonce := k.GetLastObservedEventNonce(ctx)
+	res := &types.EthereumEventParticipationResponse{LastObservedEventNonce: lastObservedEventNonce}
+
+	if req.ValidatorAddress != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s", req.ValidatorAddress)
+		}
+		validator, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "validator %s", req.ValidatorAddress)
+		}
+		res.Participation = append(res.Participation, k.ethereumEventParticipation(ctx, validator, lastObservedEventNonce))
+		return res, nil
+	}
+
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		res.Participation = append(res.Participation, k.ethereumEventParticipation(ctx, validator, lastObservedEventNonce))
+	}
+	return res, nil
+}
+
+func (k Keeper) OracleLagReport(c context.Context, req *types.OracleLagReportRequest) (*types.OracleLagReportResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	lastObservedEventNonce := k.GetLastObservedEventNonce(ctx)
+	lastObservedEthereumHeight := k.GetLastObservedEthereumBlockHeight(ctx)
+	threshold := k.GetParams(ctx).OracleLagEventThreshold
+
+	res := &types.OracleLagReportResponse{
+		LastObservedEventNonce:     lastObservedEventNonce,
+		LastObservedEthereumHeight: &lastObservedEthereumHeight,
+	}
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		res.Validators = append(res.Validators, k.validatorOracleLag(ctx, validator, lastObservedEventNonce, threshold))
+	}
+	return res, nil
+}
+
+func (k Keeper) BridgeSigningInfos(c context.Context, req *types.BridgeSigningInfosRequest) (*types.BridgeSigningInfosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.ValidatorAddress != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s", req.ValidatorAddress)
+		}
+		info := k.GetBridgeSigningInfo(ctx, valAddr)
+		if info == nil {
+			return nil, status.Errorf(codes.NotFound, "bridge signing info for validator %s", req.ValidatorAddress)
+		}
+		return &types.BridgeSigningInfosResponse{SigningInfos: []*types.BridgeSigningInfo{info}}, nil
+	}
+
+	var infos []*types.BridgeSigningInfo
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BridgeSigningInfoKey})
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var info types.BridgeSigningInfo
+		k.cdc.MustUnmarshal(value, &info)
+		infos = append(infos, &info)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BridgeSigningInfosResponse{SigningInfos: infos, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SlashingPreview(c context.Context, req *types.SlashingPreviewRequest) (*types.SlashingPreviewResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var valAddr sdk.ValAddress
+	if req.ValidatorAddress != "" {
+		var err error
+		if valAddr, err = sdk.ValAddressFromBech32(req.ValidatorAddress); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s", req.ValidatorAddress)
+		}
+	}
+
+	signers, err := k.GetOutgoingTxSigners(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// every outgoing tx whose missing signatures are not counted yet
+	otxs := k.GetUnSlashedOutgoingTxs(ctx, uint64(ctx.BlockHeight())+1)
+
+	res := &types.SlashingPreviewResponse{BridgeActive: k.GetParams(ctx).BridgeActive}
+	for _, signer := range signers {
+		if valAddr.Empty() {
+			if preview := k.slashingPreview(ctx, signer, otxs); len(preview.UnsignedOutgoingTxs) > 0 {
+				res.Validators = append(res.Validators, preview)
+			}
+		} else if signer.Validator.GetOperator().Equals(valAddr) {
+			res.Validators = append(res.Validators, k.slashingPreview(ctx, signer, otxs))
+			return res, nil
+		}
+	}
+
+	if !valAddr.Empty() {
+		return nil, status.Errorf(codes.NotFound, "validator %s is neither bonded nor unbonding", req.ValidatorAddress)
+	}
+	return res, nil
+}
+
+func (k Keeper) EthereumEventVoteRecords(c context.Context, req *types.EthereumEventVoteRecordsRequest) (*types.EthereumEventVoteRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	bonded := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+
+	prefixKey := []byte{types.EthereumEventVoteRecordKey}
+	if req.EventNonce != 0 {
+		prefixKey = append(prefixKey, sdk.Uint64ToBigEndian(req.EventNonce)...)
+	}
+
+	var records []*types.EthereumEventVoteRecordDetails
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var record types.EthereumEventVoteRecord
+		k.cdc.MustUnmarshal(value, &record)
+		details, err := k.ethereumEventVoteRecordDetails(ctx, &record, bonded)
+		if err != nil {
+			return err
+		}
+		records = append(records, details)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EthereumEventVoteRecordsResponse{
+		VoteRecords:            records,
+		LastObservedEventNonce: k.GetLastObservedEventNonce(ctx),
+		RequiredPower:          types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx)),
+		Pagination:             pageRes,
+	}, nil
+}
+
+func (k Keeper) EthereumEventVoteRecord(c context.Context, req *types.EthereumEventVoteRecordRequest) (*types.EthereumEventVoteRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	record := k.GetEthereumEventVoteRecord(ctx, req.EventNonce, req.EventHash)
+	if record == nil {
+		return nil, status.Errorf(codes.NotFound, "event vote record at nonce %d with hash %X", req.EventNonce, req.EventHash)
+	}
+
+	details, err := k.ethereumEventVoteRecordDetails(ctx, record, k.StakingKeeper.GetBondedValidatorsByPower(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EthereumEventVoteRecordResponse{
+		VoteRecord:             details,
+		LastObservedEventNonce: k.GetLastObservedEventNonce(ctx),
+		RequiredPower:          types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx)),
+	}, nil
+}
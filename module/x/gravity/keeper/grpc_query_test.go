@@ -5,6 +5,8 @@ import (
 
 	"github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 	"github.com/stretchr/testify/require"
 )
@@ -234,3 +236,195 @@ func TestKeeper_ContractCallTxs(t *testing.T) {
 // DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
 // DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
 // DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
+
+func TestKeeper_MinBridgeFees(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	minFees := []types.ERC20Token{types.NewERC20Token(10, common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"))}
+	params := gk.GetParams(ctx)
+	params.MinBridgeFees = minFees
+	gk.SetParams(ctx, params)
+
+	res, err := gk.MinBridgeFees(sdk.WrapSDKContext(ctx), &types.MinBridgeFeesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, minFees, res.MinBridgeFees)
+}
+
+func TestKeeper_UnbatchedSendToEthereumsIndexes(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		otherSender, _      = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		otherReceiver       = common.HexToAddress("0x2d5a8D2e3EBbBd5c6b0dC3fF2A7B0d0f2a1BA5A1")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	require.NoError(t, env.AddBalanceToBank(ctx, mySender, allVouchers))
+	require.NoError(t, env.AddBalanceToBank(ctx, otherSender, allVouchers))
+
+	env.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+	env.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, otherSender, myReceiver, 4)
+	env.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, otherReceiver, 5)
+
+	bySender, err := gk.UnbatchedSendToEthereums(sdk.WrapSDKContext(ctx), &types.UnbatchedSendToEthereumsRequest{
+		SenderAddress: mySender.String(),
+		Pagination:    &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.SendToEthereum{
+		types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
+		types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+	}, bySender.SendToEthereums)
+	require.Equal(t, uint64(3), bySender.Pagination.Total)
+
+	byRecipient, err := gk.UnbatchedSendToEthereumsByRecipient(sdk.WrapSDKContext(ctx), &types.UnbatchedSendToEthereumsByRecipientRequest{
+		EthereumRecipient: myReceiver.Hex(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.SendToEthereum{
+		types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
+		types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+		types.NewSendToEthereumTx(3, myTokenContractAddr, otherSender, myReceiver, 100, 4),
+	}, byRecipient.SendToEthereums)
+
+	// the indexes follow the fee increases and cancellations
+	_, err = gk.increaseBridgeFee(ctx, 1, mySender.String(), types.NewERC20Token(5, myTokenContractAddr).GravityCoin())
+	require.NoError(t, err)
+	byID, err := gk.SendToEthereumByID(sdk.WrapSDKContext(ctx), &types.SendToEthereumByIDRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 7), byID.SendToEthereum)
+
+	require.NoError(t, gk.cancelSendToEthereum(ctx, 1, mySender.String()))
+	_, err = gk.SendToEthereumByID(sdk.WrapSDKContext(ctx), &types.SendToEthereumByIDRequest{Id: 1})
+	require.Error(t, err)
+
+	byRecipient, err = gk.UnbatchedSendToEthereumsByRecipient(sdk.WrapSDKContext(ctx), &types.UnbatchedSendToEthereumsByRecipientRequest{
+		EthereumRecipient: otherReceiver.Hex(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.SendToEthereum{
+		types.NewSendToEthereumTx(4, myTokenContractAddr, mySender, otherReceiver, 100, 5),
+	}, byRecipient.SendToEthereums)
+
+	_, err = gk.UnbatchedSendToEthereumsByRecipient(sdk.WrapSDKContext(ctx), &types.UnbatchedSendToEthereumsByRecipientRequest{
+		EthereumRecipient: "not an address",
+	})
+	require.Error(t, err)
+}
+
+func TestKeeper_SimulateBatchTx(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	require.NoError(t, env.AddBalanceToBank(ctx, mySender, allVouchers))
+
+	params := gk.GetParams(ctx)
+	params.BatchMaxElement = 2
+	gk.SetParams(ctx, params)
+	gk.SetLastObservedEthereumBlockHeight(ctx, 1000)
+
+	env.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 2, 1)
+
+	_, err := gk.SimulateBatchTx(sdk.WrapSDKContext(ctx), &types.SimulateBatchTxRequest{TokenContract: "invalid"})
+	require.Error(t, err)
+
+	res, err := gk.SimulateBatchTx(sdk.WrapSDKContext(ctx), &types.SimulateBatchTxRequest{TokenContract: myTokenContractAddr.Hex()})
+	require.NoError(t, err)
+	expTxs := []*types.SendToEthereum{
+		types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+		types.NewSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
+	}
+	require.Equal(t, expTxs, res.Transactions)
+	require.Equal(t, sdk.NewInt(5), res.TotalFees)
+	// the default 60001ms timeout adds 4 ethereum blocks of 15s
+	require.Equal(t, uint64(1004), res.Timeout)
+	require.False(t, res.MoreProfitableBatchExists)
+
+	// nothing was written
+	require.Len(t, gk.getUnbatchedSendToEthereums(ctx), 4)
+	require.Nil(t, gk.getLastOutgoingBatchByTokenType(ctx, myTokenContractAddr))
+
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 2)
+	require.NotNil(t, batch)
+	require.Equal(t, expTxs, batch.Transactions)
+	require.Equal(t, res.Timeout, batch.Timeout)
+
+	// a batch without fees would not beat the outstanding batch
+	require.NoError(t, gk.cancelSendToEthereum(ctx, 1, mySender.String()))
+	require.NoError(t, gk.cancelSendToEthereum(ctx, 4, mySender.String()))
+	env.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 0)
+
+	res, err = gk.SimulateBatchTx(sdk.WrapSDKContext(ctx), &types.SimulateBatchTxRequest{TokenContract: myTokenContractAddr.Hex()})
+	require.NoError(t, err)
+	require.True(t, res.MoreProfitableBatchExists)
+	require.Equal(t, []*types.SendToEthereum{
+		types.NewSendToEthereumTx(5, myTokenContractAddr, mySender, myReceiver, 100, 0),
+	}, res.Transactions)
+	require.True(t, res.TotalFees.IsZero())
+}
+
+func TestKeeper_EthereumEventVoteRecords(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	msgServer := NewMsgServerImpl(gk)
+
+	submit := func(i int, amount int64) types.EthereumEvent {
+		event := &types.SendToCosmosEvent{
+			EventNonce:     1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: 100,
+		}
+		any, err := types.PackEvent(event)
+		require.NoError(t, err)
+		_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumEvent{
+			Event:  any,
+			Signer: AccAddrs[i].String(),
+		})
+		require.NoError(t, err)
+		return event
+	}
+	event := submit(0, 1000)
+	submit(1, 1000)
+	submit(2, 2000)
+
+	res, err := gk.EthereumEventVoteRecords(sdk.WrapSDKContext(ctx), &types.EthereumEventVoteRecordsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.VoteRecords, 2)
+	require.Equal(t, types.EventVoteRecordPowerThreshold(input.StakingKeeper.GetLastTotalPower(ctx)), res.RequiredPower)
+
+	res, err = gk.EthereumEventVoteRecords(sdk.WrapSDKContext(ctx), &types.EthereumEventVoteRecordsRequest{
+		EventNonce: 1,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.VoteRecords, 1)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	_, err = gk.EthereumEventVoteRecord(sdk.WrapSDKContext(ctx), &types.EthereumEventVoteRecordRequest{EventNonce: 1, EventHash: []byte("unknown")})
+	require.Error(t, err)
+
+	single, err := gk.EthereumEventVoteRecord(sdk.WrapSDKContext(ctx), &types.EthereumEventVoteRecordRequest{EventNonce: 1, EventHash: event.Hash()})
+	require.NoError(t, err)
+	details := single.VoteRecord
+	require.Equal(t, uint64(1), details.EventNonce)
+	require.Equal(t, []byte(event.Hash()), details.EventHash)
+	require.Equal(t, []string{ValAddrs[0].String(), ValAddrs[1].String()}, details.VoteRecord.Votes)
+	votePower := input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[0]) + input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[1])
+	require.Equal(t, sdk.NewInt(votePower), details.VotePower)
+	// the validator that voted for the other event is not waited on
+	require.ElementsMatch(t, []string{ValAddrs[3].String(), ValAddrs[4].String()}, details.UnvotedValidators)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
 
@@ -15,7 +16,7 @@ var _ stakingtypes.StakingHooks = Hooks{}
 // Hooks Create new gravity hooks
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
 
-func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
 
 	// When Validator starts Unbonding, Persist the block height in the store
 	// Later in endblocker, check if there is at least one validator who started unbonding and create a valset request.
@@ -23,6 +24,13 @@ func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress,
 	// if multiple validators starts unbonding at same block.
 
 	h.k.setLastUnbondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
+
+	// A jailed validator leaves the bonded set at the end of the block it was jailed in, so the
+	// signer set tx created at the start of the next block must drop it
+	if validator, found := h.k.StakingKeeper.GetValidator(ctx, valAddr); found && validator.IsJailed() &&
+		h.k.GetValidatorEthereumAddress(ctx, valAddr) != (common.Address{}) {
+		h.k.setLastSignerSetChangeBlockHeight(ctx, uint64(ctx.BlockHeight()))
+	}
 	return nil
 }
 
@@ -31,7 +39,10 @@ func (h Hooks) BeforeDelegationCreated(_ sdk.Context, delAddr sdk.AccAddress, va
 }
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error { return nil }
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error       { return nil }
-func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	// A validator cannot vote on events while it is not bonded, so the events accepted until it bonds
+	// are not held against it
+	h.k.setEthereumEventExcusedNonce(ctx, valAddr, h.k.GetLastObservedEventNonce(ctx))
 	return nil
 }
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
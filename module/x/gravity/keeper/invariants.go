@@ -37,6 +37,7 @@ func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 		}
 		expectedBals = sumUnconfirmedBatchModuleBalances(ctx, k, expectedBals)
 		expectedBals = sumUnbatchedSendToEthereumsModuleBalances(ctx, k, expectedBals)
+		expectedBals = sumQuarantinedDepositsModuleBalances(ctx, k, expectedBals)
 
 		// Compare actual vs expected balances
 		for _, actual := range actualBals {
@@ -110,3 +111,24 @@ func sumUnbatchedSendToEthereumsModuleBalances(ctx sdk.Context, k Keeper, expect
 
 	return expectedBals
 }
+
+// sumQuarantinedDepositsModuleBalances calculates the value the module should have stored due to quarantined deposits
+func sumQuarantinedDepositsModuleBalances(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
+	k.IterateQuarantinedDeposits(ctx, func(deposit *types.QuarantinedDeposit) bool {
+		if deposit.Status != types.QuarantineStatus_QUARANTINE_STATUS_QUARANTINED {
+			return false // continue iterating
+		}
+		_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(deposit.Deposit.TokenContract))
+
+		_, ok := expectedBals[denom]
+		if !ok {
+			zero := sdk.ZeroInt()
+			expectedBals[denom] = &zero
+		}
+		*expectedBals[denom] = expectedBals[denom].Add(deposit.Deposit.Amount)
+
+		return false // continue iterating
+	})
+
+	return expectedBals
+}
@@ -64,7 +64,7 @@ func TestModuleBalanceUnbatchedTxs(t *testing.T) {
 	checkInvariant(t, ctx, input.GravityKeeper, true)
 
 	// Execute batch and check
-	input.GravityKeeper.batchTxExecuted(ctx, myTokenContractAddr, batch.BatchNonce)
+	input.GravityKeeper.batchTxExecuted(ctx, myTokenContractAddr, batch.BatchNonce, 0)
 	checkInvariant(t, ctx, input.GravityKeeper, true)
 
 	// Ensure an error is returned for a mismatched balance
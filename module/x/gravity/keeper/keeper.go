@@ -126,6 +126,22 @@ func (k Keeper) GetLastUnbondingBlockHeight(ctx sdk.Context) uint64 {
 	}
 }
 
+// setLastSignerSetChangeBlockHeight sets the last block height a bonded validator changed its
+// ethereum address or was jailed
+func (k Keeper) setLastSignerSetChangeBlockHeight(ctx sdk.Context, blockHeight uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastSignerSetChangeBlockHeightKey}, sdk.Uint64ToBigEndian(blockHeight))
+}
+
+// GetLastSignerSetChangeBlockHeight returns the last block height a bonded validator changed its
+// ethereum address or was jailed
+func (k Keeper) GetLastSignerSetChangeBlockHeight(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastSignerSetChangeBlockHeightKey}); len(bz) == 0 {
+		return 0
+	} else {
+		return binary.BigEndian.Uint64(bz)
+	}
+}
+
 ///////////////////////////////
 //     ETHEREUM SIGNATURES   //
 ///////////////////////////////
@@ -272,6 +288,8 @@ func (k Keeper) CreateSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 		),
 	)
 	k.SetOutgoingTx(ctx, newSignerSetTx)
+	// the hash outlives the signer set tx, so that the signer set can be checked when observed
+	k.setSignerSetHash(ctx, nonce, signerSetHash(newSignerSetTx.Signers))
 	k.Logger(ctx).Info(
 		"SignerSetTx created",
 		"nonce", newSignerSetTx.Nonce,
@@ -414,7 +432,7 @@ func (k Keeper) GetUnbondingvalidators(unbondingVals []byte) stakingtypes.ValAdd
 }
 
 // This gets the timeout height in Ethereum blocks for expiring old batches and contract calls.
-func (k Keeper) getTimeoutHeight(ctx sdk.Context) uint64 {
+func (k Keeper) getTimeoutHeight(ctx sdk.Context, targetEthTxTimeout uint64) uint64 {
 	params := k.GetParams(ctx)
 	currentCosmosHeight := ctx.BlockHeight()
 	// we store the last observed Cosmos and Ethereum heights, we do not concern ourselves if these values are zero because
@@ -429,7 +447,7 @@ func (k Keeper) getTimeoutHeight(ctx sdk.Context) uint64 {
 	projectedCurrentEthereumHeight := (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumHeight
 	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
 	// place on top of our projection of the current Ethereum block height.
-	blocksToAdd := params.TargetEthTxTimeout / params.AverageEthereumBlockTime
+	blocksToAdd := targetEthTxTimeout / params.AverageEthereumBlockTime
 	return projectedCurrentEthereumHeight + blocksToAdd
 }
 
@@ -450,17 +468,81 @@ func (k Keeper) SetOutgoingTx(ctx sdk.Context, outgoing types.OutgoingTx) {
 	if err != nil {
 		panic(err)
 	}
+	// a replaced tx must not stay queued at its previous timeout
+	k.deleteOutgoingTxIndexes(ctx, outgoing.GetStoreIndex())
 	ctx.KVStore(k.storeKey).Set(
 		types.MakeOutgoingTxKey(outgoing.GetStoreIndex()),
 		k.cdc.MustMarshal(any),
 	)
+	k.setOutgoingTxIndexes(ctx, outgoing)
+	// checkpoints are kept after the tx is gone so signatures over them are never taken as evidence
+	k.setEthereumSignatureCheckpoint(ctx, outgoing.GetCheckpoint([]byte(k.getGravityID(ctx))))
 }
 
 // DeleteOutgoingTx deletes a given outgoingtx
 func (k Keeper) DeleteOutgoingTx(ctx sdk.Context, storeIndex []byte) {
+	k.deleteOutgoingTxIndexes(ctx, storeIndex)
 	ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxKey(storeIndex))
 }
 
+// setOutgoingTxIndexes indexes every outgoing tx by creation height, batch txs by token and nonce,
+// contract call txs by invalidation scope and nonce, and both by their timeout height on ethereum
+func (k Keeper) setOutgoingTxIndexes(ctx sdk.Context, outgoing types.OutgoingTx) {
+	store := ctx.KVStore(k.storeKey)
+	storeIndex := outgoing.GetStoreIndex()
+	store.Set(types.MakeOutgoingTxByHeightKey(outgoing.GetCosmosHeight(), storeIndex), storeIndex)
+	switch otx := outgoing.(type) {
+	case *types.BatchTx:
+		store.Set(types.MakeOutgoingBatchTxByTokenKey(common.HexToAddress(otx.TokenContract), otx.BatchNonce), storeIndex)
+		store.Set(types.MakeOutgoingTxTimeoutKey(types.BatchTxPrefixByte, otx.Timeout, storeIndex), storeIndex)
+	case *types.ContractCallTx:
+		store.Set(types.MakeOutgoingContractCallTxByScopeKey(otx.InvalidationScope, otx.InvalidationNonce), storeIndex)
+		store.Set(types.MakeOutgoingTxTimeoutKey(types.ContractCallTxPrefixByte, otx.Timeout, storeIndex), storeIndex)
+	}
+}
+
+func (k Keeper) deleteOutgoingTxIndexes(ctx sdk.Context, storeIndex []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.MakeOutgoingTxKey(storeIndex)) {
+		return
+	}
+	outgoing := k.GetOutgoingTx(ctx, storeIndex)
+	store.Delete(types.MakeOutgoingTxByHeightKey(outgoing.GetCosmosHeight(), storeIndex))
+	switch otx := outgoing.(type) {
+	case *types.BatchTx:
+		store.Delete(types.MakeOutgoingBatchTxByTokenKey(common.HexToAddress(otx.TokenContract), otx.BatchNonce))
+		store.Delete(types.MakeOutgoingTxTimeoutKey(types.BatchTxPrefixByte, otx.Timeout, storeIndex))
+	case *types.ContractCallTx:
+		store.Delete(types.MakeOutgoingContractCallTxByScopeKey(otx.InvalidationScope, otx.InvalidationNonce))
+		store.Delete(types.MakeOutgoingTxTimeoutKey(types.ContractCallTxPrefixByte, otx.Timeout, storeIndex))
+	}
+}
+
+// iterateOutgoingTxIndex iterates over the outgoing txs whose store index is the value of the index
+// entries in [start, end), in reverse order if reverse is set
+func (k Keeper) iterateOutgoingTxIndex(ctx sdk.Context, start, end []byte, reverse bool, cb func(outgoing types.OutgoingTx) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	var iter sdk.Iterator
+	if reverse {
+		iter = store.ReverseIterator(start, end)
+	} else {
+		iter = store.Iterator(start, end)
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(k.GetOutgoingTx(ctx, iter.Value())) {
+			break
+		}
+	}
+}
+
+// IterateTimedOutOutgoingTxs iterates over the outgoing txs of a type, denoted by the chosen prefix
+// byte, whose timeout is lower than the given ethereum height, earliest timeout first
+func (k Keeper) IterateTimedOutOutgoingTxs(ctx sdk.Context, prefixByte byte, ethereumHeight uint64, cb func(outgoing types.OutgoingTx) (stop bool)) {
+	prefix := types.MakeOutgoingTxTimeoutPrefix(prefixByte)
+	k.iterateOutgoingTxIndex(ctx, prefix, append(prefix, sdk.Uint64ToBigEndian(ethereumHeight)...), false, cb)
+}
+
 func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, pageReq *query.PageRequest, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) bool) (*query.PageResponse, error) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey([]byte{prefixByte}))
 
@@ -501,24 +583,6 @@ func (k Keeper) IterateOutgoingTxsByType(ctx sdk.Context, prefixByte byte, cb fu
 	}
 }
 
-// iterateOutgoingTxs iterates over a specific type of outgoing transaction denoted by the chosen prefix byte
-func (k Keeper) iterateOutgoingTxs(ctx sdk.Context, cb func(key []byte, outgoing types.OutgoingTx) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxKey})
-	iter := prefixStore.ReverseIterator(nil, nil)
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		var any cdctypes.Any
-		k.cdc.MustUnmarshal(iter.Value(), &any)
-		var otx types.OutgoingTx
-		if err := k.cdc.UnpackAny(&any, &otx); err != nil {
-			panic(err)
-		}
-		if cb(iter.Key(), otx) {
-			break
-		}
-	}
-}
-
 // GetLastObservedSignerSetTx retrieves the last observed validator set from the store
 func (k Keeper) GetLastObservedSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 	key := []byte{types.LastObservedSignerSetKey}
@@ -546,7 +610,7 @@ func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64,
 		InvalidationScope: invalidationScope,
 		Address:           address.String(),
 		Payload:           payload,
-		Timeout:           k.getTimeoutHeight(ctx),
+		Timeout:           k.getTimeoutHeight(ctx, params.TargetEthTxTimeout),
 		Tokens:            tokens,
 		Fees:              fees,
 		Height:            uint64(ctx.BlockHeight()),
@@ -663,7 +727,7 @@ func (k Keeper) DeleteEthereumSignatures(ctx sdk.Context, otx types.OutgoingTx)
 // We will have yet to implement functionality to Migrate the Cosmos ERC20 tokens or any other ERC20 tokens bridged to the gravity contracts.
 // This just does keeper state cleanup if a new gravity contract has been deployed
 func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string, bridgeDeploymentHeight uint64) {
-	// Delete Any Outgoing TXs.
+	// Delete Any Outgoing TXs, along with their indexes.
 	prefixStoreOtx := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxKey})
 	iterOtx := prefixStoreOtx.ReverseIterator(nil, nil)
 	defer iterOtx.Close()
@@ -672,7 +736,7 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 		oxtToDeleteKeys = append(oxtToDeleteKeys, iterOtx.Key())
 	}
 	for _, key := range oxtToDeleteKeys {
-		prefixStoreOtx.Delete(key)
+		k.DeleteOutgoingTx(ctx, key)
 	}
 
 	// Delete all ethereum signature for all Outgoing TXs.
@@ -691,6 +755,22 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 	store := ctx.KVStore(k.storeKey)
 	store.Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(0))
 
+	// Delete the signer set hashes, the new contract starts its signer set nonces over
+	prefixStoreSignerSetHash := prefix.NewStore(store, []byte{types.SignerSetHashKey})
+	iterSignerSetHash := prefixStoreSignerSetHash.Iterator(nil, nil)
+	defer iterSignerSetHash.Close()
+	var signerSetHashToDeleteKeys [][]byte
+	for ; iterSignerSetHash.Valid(); iterSignerSetHash.Next() {
+		signerSetHashToDeleteKeys = append(signerSetHashToDeleteKeys, iterSignerSetHash.Key())
+	}
+	for _, key := range signerSetHashToDeleteKeys {
+		prefixStoreSignerSetHash.Delete(key)
+	}
+
+	// Delete the bad signature evidence floor, the new contract starts its nonces over and the
+	// checkpoints of every outgoing tx it sees are kept
+	store.Delete([]byte{types.BadSignatureEvidenceFloorKey})
+
 	// Reset ethereum event nonce to zero
 	k.setLastObservedEventNonce(ctx, 0)
 	// Reset all validators ethereum event nonce to zero
@@ -703,6 +783,20 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 		k.setLastEventNonceByValidator(ctx, validator, 0)
 	}
 
+	// Delete the accepted event heights and excused event nonces, they refer to the old event nonces
+	for _, prefixByte := range []byte{types.EthereumEventAcceptedHeightKey, types.EthereumEventExcusedNonceKey} {
+		prefixStoreParticipation := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{prefixByte})
+		iterParticipation := prefixStoreParticipation.Iterator(nil, nil)
+		var participationToDeleteKeys [][]byte
+		for ; iterParticipation.Valid(); iterParticipation.Next() {
+			participationToDeleteKeys = append(participationToDeleteKeys, iterParticipation.Key())
+		}
+		iterParticipation.Close()
+		for _, key := range participationToDeleteKeys {
+			prefixStoreParticipation.Delete(key)
+		}
+	}
+
 	// Delete all Ethereum Events
 	prefixStoreEthereumEvent := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
 	iterEvent := prefixStoreEthereumEvent.Iterator(nil, nil)
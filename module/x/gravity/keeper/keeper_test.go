@@ -253,6 +253,33 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	assert.Equal(t, 6, len(unslashedValsets))
 }
 
+func TestGetUnSlashedOutgoingTxsByHeight(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	k.SetOutgoingTx(ctx, &types.BatchTx{BatchNonce: 1, TokenContract: tokenContract.Hex(), Height: 7})
+	k.SetOutgoingTx(ctx, &types.SignerSetTx{Nonce: 1, Height: 5})
+	k.SetOutgoingTx(ctx, &types.ContractCallTx{InvalidationScope: []byte("scope"), InvalidationNonce: 1, Height: 6})
+	k.SetOutgoingTx(ctx, &types.BatchTx{BatchNonce: 2, TokenContract: tokenContract.Hex(), Height: 9})
+	k.DeleteOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, 2))
+
+	heights := func(otxs []types.OutgoingTx) (out []uint64) {
+		for _, otx := range otxs {
+			out = append(out, otx.GetCosmosHeight())
+		}
+		return out
+	}
+
+	// oldest first, whatever their type, leaving out deleted txs
+	assert.Equal(t, []uint64{5, 6, 7}, heights(k.GetUnSlashedOutgoingTxs(ctx, 10)))
+
+	k.SetLastSlashedOutgoingTxBlockHeight(ctx, 5)
+	assert.Equal(t, []uint64{6}, heights(k.GetUnSlashedOutgoingTxs(ctx, 7)))
+	assert.Empty(t, k.GetUnSlashedOutgoingTxs(ctx, 6))
+}
+
 // ---
 
 func TestKeeper_GetLatestSignerSetTx(t *testing.T) {
@@ -687,6 +714,9 @@ func TestKeeper_Migration(t *testing.T) {
 		require.Len(t, got, 0)
 	}
 
+	// the outgoing tx indexes are cleared along with the txs
+	require.Nil(t, gk.getLastOutgoingBatchByTokenType(ctx, myTokenContractAddr))
+	require.Empty(t, gk.GetUnSlashedOutgoingTxs(ctx, uint64(ctx.BlockHeight())+1))
 }
 
 // TODO(levi) review/ensure coverage for:
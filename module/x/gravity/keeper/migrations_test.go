@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	require.NoError(t, input.AddBalanceToBank(ctx, mySender, allVouchers))
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 4)
+	batch := input.GravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 1)
+	require.NotNil(t, batch)
+	signerSet := input.GravityKeeper.CreateSignerSetTx(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	contractCalls := []*types.ContractCallTx{
+		{InvalidationScope: []byte("scope"), InvalidationNonce: 5},
+		{InvalidationScope: []byte("scope"), InvalidationNonce: 3},
+	}
+	for _, cctx := range contractCalls {
+		input.GravityKeeper.SetOutgoingTx(ctx, cctx)
+	}
+
+	// drop the pool heights, indexes and statuses to reproduce a v2 store
+	store := ctx.KVStore(input.GravityStoreKey)
+	for _, id := range []uint64{1, 2} {
+		height, found := input.GravityKeeper.getSendToEthereumHeight(ctx, id)
+		require.True(t, found)
+		store.Delete(types.MakeSendToEthereumHeightKey(id))
+		store.Delete(types.MakeSendToEthereumPoolHeightKey(height, id))
+		store.Delete(types.MakeSendToEthereumByIDKey(id))
+		store.Delete(types.MakeSendToEthereumBySenderKey(mySender, id))
+		store.Delete(types.MakeSendToEthereumByRecipientKey(myReceiver, id))
+		store.Delete(types.MakeSendToEthereumByContractKey(myTokenContractAddr, id))
+	}
+	require.Nil(t, input.GravityKeeper.getUnbatchedSendToEthereum(ctx, 1))
+	for _, id := range []uint64{1, 2, 3} {
+		store.Delete(types.MakeSendToEthereumStatusKey(id))
+	}
+	store.Delete(types.MakeOutgoingBatchTxByTokenKey(myTokenContractAddr, batch.BatchNonce))
+	store.Delete(types.MakeOutgoingTxTimeoutKey(types.BatchTxPrefixByte, batch.Timeout, batch.GetStoreIndex()))
+	store.Delete(types.MakeOutgoingTxByHeightKey(batch.Height, batch.GetStoreIndex()))
+	require.Nil(t, input.GravityKeeper.getLastOutgoingBatchByTokenType(ctx, myTokenContractAddr))
+	store.Delete(types.MakeSignerSetHashKey(signerSet.Nonce))
+	gravityID := []byte(input.GravityKeeper.getGravityID(ctx))
+	for _, otx := range []types.OutgoingTx{batch, signerSet, contractCalls[0], contractCalls[1]} {
+		store.Delete(types.MakeEthereumSignatureCheckpointKey(otx.GetCheckpoint(gravityID)))
+	}
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.NoError(t, NewMigrator(input.GravityKeeper).Migrate2to3(ctx))
+
+	var indexed []uint64
+	input.GravityKeeper.IterateUnbatchedSendToEthereumsByPoolHeight(ctx, uint64(ctx.BlockHeight()), func(ste *types.SendToEthereum) bool {
+		indexed = append(indexed, ste.Id)
+		return false
+	})
+	require.Equal(t, []uint64{1, 2}, indexed)
+
+	for _, id := range indexed {
+		height, found := input.GravityKeeper.getSendToEthereumHeight(ctx, id)
+		require.True(t, found)
+		require.Equal(t, uint64(ctx.BlockHeight()), height)
+	}
+
+	for _, id := range indexed {
+		require.NotNil(t, input.GravityKeeper.getUnbatchedSendToEthereum(ctx, id))
+	}
+	var bySender, byRecipient, byAge []uint64
+	input.GravityKeeper.IterateUnbatchedSendToEthereumsBySender(ctx, mySender, func(ste *types.SendToEthereum) bool {
+		bySender = append(bySender, ste.Id)
+		return false
+	})
+	input.GravityKeeper.IterateUnbatchedSendToEthereumsByRecipient(ctx, myReceiver, func(ste *types.SendToEthereum) bool {
+		byRecipient = append(byRecipient, ste.Id)
+		return false
+	})
+	input.GravityKeeper.iterateUnbatchedSendToEthereumsByAge(ctx, myTokenContractAddr, func(ste *types.SendToEthereum) bool {
+		byAge = append(byAge, ste.Id)
+		return false
+	})
+	require.Equal(t, []uint64{1, 2}, bySender)
+	require.Equal(t, []uint64{1, 2}, byRecipient)
+	require.Equal(t, []uint64{1, 2}, byAge)
+
+	for _, id := range indexed {
+		status := input.GravityKeeper.GetSendToEthereumStatus(ctx, id)
+		require.NotNil(t, status)
+		require.Equal(t, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_POOLED, status.State)
+	}
+	status := input.GravityKeeper.GetSendToEthereumStatus(ctx, 3)
+	require.NotNil(t, status)
+	require.Equal(t, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_BATCHED, status.State)
+	require.Equal(t, batch.BatchNonce, status.BatchNonce)
+
+	require.Equal(t, batch, input.GravityKeeper.getLastOutgoingBatchByTokenType(ctx, myTokenContractAddr))
+	var timedOut []types.OutgoingTx
+	input.GravityKeeper.IterateTimedOutOutgoingTxs(ctx, types.BatchTxPrefixByte, batch.Timeout+1, func(otx types.OutgoingTx) bool {
+		timedOut = append(timedOut, otx)
+		return false
+	})
+	require.Equal(t, []types.OutgoingTx{batch}, timedOut)
+	require.Equal(t, []types.OutgoingTx{batch}, input.GravityKeeper.GetUnSlashedOutgoingTxs(ctx, batch.Height+1))
+	require.Equal(t, signerSetHash(signerSet.Signers), input.GravityKeeper.GetSignerSetHash(ctx, signerSet.Nonce))
+	for _, otx := range []types.OutgoingTx{batch, signerSet} {
+		require.True(t, input.GravityKeeper.hasEthereumSignatureCheckpoint(ctx, otx.GetCheckpoint(gravityID)))
+	}
+	require.Equal(t, uint64(ctx.BlockHeight()), input.GravityKeeper.getUntrackedEthereumEventAcceptedHeight(ctx))
+	require.Equal(t, &types.BadSignatureEvidenceFloor{
+		SignerSetNonce:     signerSet.Nonce,
+		BatchNonce:         batch.BatchNonce,
+		ContractCallScopes: []*types.ContractCallScopeFloor{{InvalidationScope: []byte("scope"), InvalidationNonce: 5}},
+	}, input.GravityKeeper.GetBadSignatureEvidenceFloor(ctx))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	require.Equal(t, uint64(0), params.SendToEthereumMaxPoolAge)
+	require.Equal(t, uint64(0), params.SendToEthereumMaxBatchTimeouts)
+	require.Empty(t, params.MinBridgeFees)
+	require.Empty(t, params.OutflowLimits)
+	require.Empty(t, params.InflowLimits)
+	require.Equal(t, types.DefaultParams().SendToEthereumStatusRetention, params.SendToEthereumStatusRetention)
+	require.Equal(t, types.BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_FEE_GREEDY, params.BatchSelectionStrategy)
+	require.Equal(t, types.DefaultParams().BatchOldestShare, params.BatchOldestShare)
+	require.Empty(t, params.BatchTriggers)
+	require.Empty(t, params.BatchSettingsOverrides)
+	require.Equal(t, types.DefaultParams().ExecutedBatchStatsWindow, params.ExecutedBatchStatsWindow)
+	require.Equal(t, types.DefaultParams().SignerSetPowerDiffThreshold, params.SignerSetPowerDiffThreshold)
+	require.Equal(t, uint64(0), params.SignerSetMaxAge)
+	require.Equal(t, types.DefaultParams().SlashFractionBadEthereumSignature, params.SlashFractionBadEthereumSignature)
+	require.False(t, params.ConflictingEthereumEventSlashing)
+	require.Equal(t, uint64(3), params.ConflictingEthereumEventGraceVotes)
+	require.Equal(t, uint64(10000), params.ConflictingEthereumEventGraceWindow)
+	require.Equal(t, types.EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_DISABLED, params.EthereumEventVoteSlashingMode)
+	require.Equal(t, types.DefaultParams().SlashFractionContractCallTx, params.SlashFractionContractCallTx)
+	require.Equal(t, uint64(100), params.BridgeSigningWindow)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), params.BridgeSigningMaxMissedRatio)
+	require.Equal(t, uint64(10), params.OracleLagEventThreshold)
+}
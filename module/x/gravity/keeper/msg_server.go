@@ -91,6 +91,12 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 		)
 	}
 
+	// a bonded validator moving to a new ethereum address needs a signer set with that address
+	if prevEthAddr := k.GetValidatorEthereumAddress(ctx, valAddr); prevEthAddr != (common.Address{}) && prevEthAddr != ethAddr &&
+		k.Keeper.StakingKeeper.Validator(ctx, valAddr).IsBonded() {
+		k.setLastSignerSetChangeBlockHeight(ctx, uint64(ctx.BlockHeight()))
+	}
+
 	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
 	k.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
 	k.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
@@ -303,7 +309,7 @@ func (k msgServer) RequestBatchTx(c context.Context, msg *types.MsgRequestBatchT
 		return nil, err
 	}
 
-	batchTx := k.BuildBatchTx(ctx, tokenContract, int(params.BatchMaxElement))
+	batchTx := k.BuildBatchTx(ctx, tokenContract, int(k.GetBatchSettings(ctx, tokenContract).BatchMaxElement))
 	if batchTx == nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "no suitable batch to create")
 	}
@@ -349,6 +355,41 @@ func (k msgServer) CancelSendToEthereum(c context.Context, msg *types.MsgCancelS
 	return &types.MsgCancelSendToEthereumResponse{}, nil
 }
 
+// IncreaseBridgeFee handles MsgIncreaseBridgeFee
+func (k msgServer) IncreaseBridgeFee(c context.Context, msg *types.MsgIncreaseBridgeFee) (*types.MsgIncreaseBridgeFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	if !params.BridgeActive {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "the bridge is disabled")
+	}
+
+	// ensure the denom provided in the message will map correctly if it is a gravity denom
+	types.NormalizeCoinDenom(&msg.FeeIncrease)
+
+	send, err := k.Keeper.increaseBridgeFee(ctx, msg.Id, msg.Sender, msg.FeeIncrease)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeBridgeFeeIncreased,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(send.Id)),
+			sdk.NewAttribute(types.AttributeKeyBridgeFee, send.Erc20Fee.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.Id)),
+		),
+	})
+
+	return &types.MsgIncreaseBridgeFeeResponse{}, nil
+}
+
 func (k msgServer) SubmitEthereumHeightVote(c context.Context, msg *types.MsgEthereumHeightVote) (*types.MsgEthereumHeightVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	params := k.GetParams(ctx)
@@ -366,6 +407,26 @@ func (k msgServer) SubmitEthereumHeightVote(c context.Context, msg *types.MsgEth
 	return &types.MsgEthereumHeightVoteResponse{}, nil
 }
 
+// SubmitBadSignatureEvidence handles MsgSubmitBadSignatureEvidence. It stays open while the
+// bridge is disabled, since that is when a forged signer set or batch is most likely in play.
+func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := k.checkBadSignatureEvidence(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyEthereumAddress, msg.EthereumSigner),
+		),
+	)
+
+	return &types.MsgSubmitBadSignatureEvidenceResponse{}, nil
+}
+
 // getSignerValidator takes an sdk.AccAddress that represents either a validator or orchestrator address and returns
 // the assoicated validator address
 func (k Keeper) getSignerValidator(ctx sdk.Context, signerString string) (sdk.ValAddress, error) {
@@ -136,8 +136,34 @@ func TestMsgServer_SendToEthereum(t *testing.T) {
 		BridgeFee:         fee,
 	}
 
+	// a fee below the minimum is rejected
+	params := gk.GetParams(ctx)
+	params.MinBridgeFees = []types.ERC20Token{types.NewERC20Token(11, testContract)}
+	gk.SetParams(ctx, params)
+	_, err = msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrBridgeFeeTooLow)
+
+	params.MinBridgeFees = []types.ERC20Token{types.NewERC20Token(10, testContract)}
+	gk.SetParams(ctx, params)
+	_, err = msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// denied recipients are rejected
+	for _, recipient := range []common.Address{{}, common.HexToAddress(params.BridgeEthereumAddress), testContract} {
+		msg.EthereumRecipient = recipient.Hex()
+		_, err = msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrDeniedEthereumRecipient)
+	}
+
+	require.NoError(t, gk.HandleAddEthereumDenylistProposal(ctx, types.NewAddEthereumDenylistProposal("deny", "deny", []string{ethAddr1.Hex()})))
+	msg.EthereumRecipient = ethAddr1.Hex()
+	_, err = msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrDeniedEthereumRecipient)
+
+	require.NoError(t, gk.HandleRemoveEthereumDenylistProposal(ctx, types.NewRemoveEthereumDenylistProposal("allow", "allow", []string{ethAddr1.Hex()})))
 	_, err = msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
 	require.NoError(t, err)
+	require.Error(t, gk.HandleRemoveEthereumDenylistProposal(ctx, types.NewRemoveEthereumDenylistProposal("allow", "allow", []string{ethAddr1.Hex()})))
 }
 
 func TestMsgServer_CancelSendToEthereum(t *testing.T) {
@@ -213,6 +239,88 @@ func TestMsgServer_CancelSendToEthereum(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_IncreaseBridgeFee(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		valAddr2    = sdk.ValAddress(orcAddr2)
+
+		testDenom    = "stake"
+		testContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+		balance = sdk.NewCoin(testDenom, sdk.NewInt(10000))
+		amount  = sdk.NewCoin(testDenom, sdk.NewInt(1000))
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1, valAddr2)
+	require.NoError(t, env.AddBalanceToBank(ctx, orcAddr1, sdk.Coins{balance}))
+	require.NoError(t, env.AddBalanceToBank(ctx, orcAddr2, sdk.Coins{balance}))
+	gk.setCosmosOriginatedDenomToERC20(ctx, testDenom, testContract)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	low, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+		Sender:            orcAddr1.String(),
+		EthereumRecipient: ethAddr1.String(),
+		Amount:            amount,
+		BridgeFee:         sdk.NewCoin(testDenom, sdk.NewInt(10)),
+	})
+	require.NoError(t, err)
+	high, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+		Sender:            orcAddr1.String(),
+		EthereumRecipient: ethAddr1.String(),
+		Amount:            amount,
+		BridgeFee:         sdk.NewCoin(testDenom, sdk.NewInt(20)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(20), gk.GetBatchFeesByTokenType(ctx, testContract, 1))
+
+	// only the original sender can bump the fee
+	_, err = msgServer.IncreaseBridgeFee(sdk.WrapSDKContext(ctx), types.NewMsgIncreaseBridgeFee(low.Id, orcAddr2, sdk.NewCoin(testDenom, sdk.NewInt(15))))
+	require.Error(t, err)
+
+	// the fee increase must be of the same token
+	_, err = msgServer.IncreaseBridgeFee(sdk.WrapSDKContext(ctx), types.NewMsgIncreaseBridgeFee(low.Id, orcAddr1, sdk.NewCoin("footoken", sdk.NewInt(15))))
+	require.Error(t, err)
+
+	_, err = msgServer.IncreaseBridgeFee(sdk.WrapSDKContext(ctx), types.NewMsgIncreaseBridgeFee(low.Id, orcAddr1, sdk.NewCoin(testDenom, sdk.NewInt(15))))
+	require.NoError(t, err)
+
+	// the sender paid both transfers and the fee increase
+	require.Equal(t, sdk.NewInt(10000-2000-10-20-15), env.BankKeeper.GetBalance(ctx, orcAddr1, testDenom).Amount)
+
+	// the bumped tx is now first in the fee ordered pool under the same ID
+	var got []*types.SendToEthereum
+	gk.iterateUnbatchedSendToEthereumsByContract(ctx, testContract, func(ste *types.SendToEthereum) bool {
+		got = append(got, ste)
+		return false
+	})
+	require.Len(t, got, 2)
+	require.Equal(t, low.Id, got[0].Id)
+	require.Equal(t, sdk.NewInt(25), got[0].Erc20Fee.Amount)
+	require.Equal(t, high.Id, got[1].Id)
+	require.Equal(t, sdk.NewInt(25), gk.GetBatchFeesByTokenType(ctx, testContract, 1))
+
+	batchTx := gk.BuildBatchTx(ctx, testContract, 1)
+	require.NotNil(t, batchTx)
+	require.Len(t, batchTx.Transactions, 1)
+	require.Equal(t, low.Id, batchTx.Transactions[0].Id)
+
+	// a batched tx can no longer be bumped
+	_, err = msgServer.IncreaseBridgeFee(sdk.WrapSDKContext(ctx), types.NewMsgIncreaseBridgeFee(low.Id, orcAddr1, sdk.NewCoin(testDenom, sdk.NewInt(1))))
+	require.Error(t, err)
+}
+
 func TestMsgServer_RequestBatchTx(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -423,6 +531,24 @@ func TestMsgServer_SetDelegateKeys(t *testing.T) {
 
 	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), msg)
 	require.NoError(t, err)
+	require.Zero(t, gk.GetLastSignerSetChangeBlockHeight(ctx))
+
+	// moving the bonded validator to a new ethereum address calls for a new signer set
+	newEthPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	newOrcAddr, _ := sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+	newSig, err := types.NewEthereumSignature(hash, newEthPrivKey)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), &types.MsgDelegateKeys{
+		ValidatorAddress:    valAddr1.String(),
+		OrchestratorAddress: newOrcAddr.String(),
+		EthereumAddress:     crypto.PubkeyToAddress(newEthPrivKey.PublicKey).String(),
+		EthSignature:        newSig,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(ctx.BlockHeight()), gk.GetLastSignerSetChangeBlockHeight(ctx))
 }
 
 func TestMsgServer_SubmitEthereumHeightVote(t *testing.T) {
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// EmitOracleLagEvents emits an oracle_lagging event for each bonded validator whose last submitted event
+// nonce falls more than OracleLagEventThreshold nonces behind the last observed event nonce. A validator
+// is flagged once the event is emitted so it is emitted again only after the validator caught up.
+func (k Keeper) EmitOracleLagEvents(ctx sdk.Context) {
+	threshold := k.GetParams(ctx).OracleLagEventThreshold
+	lastObservedEventNonce := k.GetLastObservedEventNonce(ctx)
+
+	lagging := map[string]bool{}
+	if threshold > 0 {
+		for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+			valAddr := validator.GetOperator()
+			lastEventNonce := k.getLastEventNonceByValidator(ctx, valAddr)
+			behind := oracleLag(lastObservedEventNonce, lastEventNonce)
+			if behind <= threshold {
+				continue
+			}
+
+			lagging[valAddr.String()] = true
+			if k.isLaggingOracleValidator(ctx, valAddr) {
+				continue
+			}
+			k.setLaggingOracleValidator(ctx, valAddr)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeOracleLagging,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(lastEventNonce)),
+					sdk.NewAttribute(types.AttributeKeyLastObservedNonce, fmt.Sprint(lastObservedEventNonce)),
+					sdk.NewAttribute(types.AttributeKeyNoncesBehind, fmt.Sprint(behind)),
+				),
+			)
+		}
+	}
+
+	// validators that caught up, left the bonded set, or are no longer checked are unflagged
+	var caughtUp []sdk.ValAddress
+	k.IterateLaggingOracleValidators(ctx, func(val sdk.ValAddress) bool {
+		if !lagging[val.String()] {
+			caughtUp = append(caughtUp, val)
+		}
+		return false
+	})
+	for _, val := range caughtUp {
+		ctx.KVStore(k.storeKey).Delete(types.MakeLaggingOracleValidatorKey(val))
+	}
+}
+
+// validatorOracleLag returns the last event nonce and Ethereum height a validator submitted, its delegate
+// keys, and how far behind the last observed event nonce it is
+func (k Keeper) validatorOracleLag(ctx sdk.Context, validator stakingtypes.ValidatorI, lastObservedEventNonce, threshold uint64) *types.ValidatorOracleLag {
+	valAddr := validator.GetOperator()
+	heightVote := k.GetEthereumHeightVote(ctx, valAddr)
+	lag := &types.ValidatorOracleLag{
+		ValidatorAddress:   valAddr.String(),
+		LastEventNonce:     k.getLastEventNonceByValidator(ctx, valAddr),
+		EthereumHeightVote: &heightVote,
+	}
+	lag.EventNoncesBehind = oracleLag(lastObservedEventNonce, lag.LastEventNonce)
+	lag.Lagging = threshold > 0 && lag.EventNoncesBehind > threshold
+
+	if ethAddr := k.GetValidatorEthereumAddress(ctx, valAddr); ethAddr != (common.Address{}) {
+		lag.EthereumAddress = ethAddr.Hex()
+		if orchAddr := k.GetEthereumOrchestratorAddress(ctx, ethAddr); orchAddr != nil {
+			lag.OrchestratorAddress = orchAddr.String()
+		}
+	}
+	return lag
+}
+
+func oracleLag(lastObservedEventNonce, lastEventNonce uint64) uint64 {
+	if lastObservedEventNonce > lastEventNonce {
+		return lastObservedEventNonce - lastEventNonce
+	}
+	return 0
+}
+
+func (k Keeper) isLaggingOracleValidator(ctx sdk.Context, val sdk.ValAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeLaggingOracleValidatorKey(val))
+}
+
+func (k Keeper) setLaggingOracleValidator(ctx sdk.Context, val sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Set(types.MakeLaggingOracleValidatorKey(val), []byte{0x1})
+}
+
+// IterateLaggingOracleValidators iterates over the validators an oracle_lagging event was emitted for
+func (k Keeper) IterateLaggingOracleValidators(ctx sdk.Context, cb func(val sdk.ValAddress) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.LaggingOracleValidatorKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(iter.Key()[1:])) {
+			break
+		}
+	}
+}
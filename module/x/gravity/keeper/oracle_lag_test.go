@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestEmitOracleLagEvents(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	gk.setLastObservedEventNonce(ctx, 20)
+	for i := range ValAddrs {
+		gk.setLastEventNonceByValidator(ctx, ValAddrs[i], 20)
+	}
+	gk.setLastEventNonceByValidator(ctx, ValAddrs[1], 5)
+
+	laggingEvents := func(ctx sdk.Context) []sdk.Event {
+		var events []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeOracleLagging {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	gk.EmitOracleLagEvents(ctx)
+	events := laggingEvents(ctx)
+	require.Len(t, events, 1)
+	attrs := map[string]string{}
+	for _, attr := range events[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, ValAddrs[1].String(), attrs[types.AttributeKeyValidatorAddr])
+	require.Equal(t, "5", attrs[types.AttributeKeyNonce])
+	require.Equal(t, "20", attrs[types.AttributeKeyLastObservedNonce])
+	require.Equal(t, "15", attrs[types.AttributeKeyNoncesBehind])
+
+	// the event is emitted once while the validator stays behind
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	gk.EmitOracleLagEvents(ctx)
+	require.Empty(t, laggingEvents(ctx))
+
+	// and again once it fell behind after catching up
+	gk.setLastEventNonceByValidator(ctx, ValAddrs[1], 20)
+	gk.EmitOracleLagEvents(ctx)
+	require.False(t, gk.isLaggingOracleValidator(ctx, ValAddrs[1]))
+	gk.setLastObservedEventNonce(ctx, 31)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	gk.EmitOracleLagEvents(ctx)
+	require.Len(t, laggingEvents(ctx), len(ValAddrs))
+
+	// a zero threshold emits no events
+	params := gk.GetParams(ctx)
+	params.OracleLagEventThreshold = 0
+	gk.SetParams(ctx, params)
+	gk.setLastObservedEventNonce(ctx, 100)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	gk.EmitOracleLagEvents(ctx)
+	require.Empty(t, laggingEvents(ctx))
+	require.False(t, gk.isLaggingOracleValidator(ctx, ValAddrs[0]))
+}
+
+func TestKeeper_OracleLagReport(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	gk.setLastObservedEventNonce(ctx, 20)
+	for i := range ValAddrs {
+		gk.setLastEventNonceByValidator(ctx, ValAddrs[i], 20)
+	}
+	gk.setLastEventNonceByValidator(ctx, ValAddrs[2], 8)
+	gk.SetEthereumHeightVote(ctx, ValAddrs[2], 1000)
+
+	res, err := gk.OracleLagReport(sdk.WrapSDKContext(ctx), &types.OracleLagReportRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), res.LastObservedEventNonce)
+	require.Len(t, res.Validators, len(ValAddrs))
+
+	for _, lag := range res.Validators {
+		if lag.ValidatorAddress != ValAddrs[2].String() {
+			require.Zero(t, lag.EventNoncesBehind)
+			require.False(t, lag.Lagging)
+			continue
+		}
+		require.Equal(t, EthAddrs[2].Hex(), lag.EthereumAddress)
+		require.Equal(t, AccAddrs[2].String(), lag.OrchestratorAddress)
+		require.Equal(t, uint64(8), lag.LastEventNonce)
+		require.Equal(t, uint64(12), lag.EventNoncesBehind)
+		require.Equal(t, uint64(1000), lag.EthereumHeightVote.EthereumHeight)
+		require.True(t, lag.Lagging)
+	}
+}
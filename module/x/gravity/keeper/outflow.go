@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// getOutflowLimit returns the outflow limit of a token, if governance set one
+func (k Keeper) getOutflowLimit(ctx sdk.Context, tokenContract common.Address) (types.OutflowLimit, bool) {
+	for _, limit := range k.GetParams(ctx).OutflowLimits {
+		if common.HexToAddress(limit.TokenContract) == tokenContract {
+			return limit, true
+		}
+	}
+	return types.OutflowLimit{}, false
+}
+
+// checkOutflow rejects a new send to ethereum of a paused token, or one that
+// could never fit within the outflow limit of its token
+func (k Keeper) checkOutflow(ctx sdk.Context, tokenContract common.Address, amount sdk.Int) error {
+	limit, found := k.getOutflowLimit(ctx, tokenContract)
+	if !found {
+		return nil
+	}
+	if pausedUntil, paused := k.GetOutflowPausedUntil(ctx, tokenContract); paused {
+		return sdkerrors.Wrapf(types.ErrOutflowLimit, "withdrawals of %s are paused until height %d", tokenContract.Hex(), pausedUntil)
+	}
+	if amount.GT(limit.Limit) {
+		return sdkerrors.Wrapf(types.ErrOutflowLimit, "%s is more than the limit of %s", amount, limit.Limit)
+	}
+	return nil
+}
+
+// GetOutflow returns the amount of a token batched for Ethereum within the last
+// window blocks, the current one included
+func (k Keeper) GetOutflow(ctx sdk.Context, tokenContract common.Address, window uint64) sdk.Int {
+	return k.sumWindow(ctx, types.MakeOutflowKey, tokenContract, window)
+}
+
+// addOutflow records an amount of a token batched for Ethereum at the given height
+func (k Keeper) addOutflow(ctx sdk.Context, tokenContract common.Address, height uint64, amount sdk.Int, window uint64) {
+	k.addToWindow(ctx, types.MakeOutflowKey, tokenContract, height, amount, window)
+}
+
+// GetOutflowPausedUntil returns the height until which new send to ethereums of a token are paused
+func (k Keeper) GetOutflowPausedUntil(ctx sdk.Context, tokenContract common.Address) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeOutflowPausedKey(tokenContract))
+	if bz == nil {
+		return 0, false
+	}
+	pausedUntil := binary.BigEndian.Uint64(bz)
+	return pausedUntil, uint64(ctx.BlockHeight()) < pausedUntil
+}
+
+// pauseOutflow pauses new send to ethereums of a token for the length of its outflow window
+func (k Keeper) pauseOutflow(ctx sdk.Context, limit types.OutflowLimit, outflow sdk.Int) {
+	tokenContract := common.HexToAddress(limit.TokenContract)
+	pausedUntil := uint64(ctx.BlockHeight()) + limit.Window
+	k.setOutflowPausedUntil(ctx, tokenContract, pausedUntil)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOutflowLimitReached,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, tokenContract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyOutflow, outflow.String()),
+			sdk.NewAttribute(types.AttributeKeyOutflowLimit, limit.Limit.String()),
+			sdk.NewAttribute(types.AttributeKeyPausedUntil, fmt.Sprint(pausedUntil)),
+		),
+	)
+}
+
+func (k Keeper) setOutflowPausedUntil(ctx sdk.Context, tokenContract common.Address, pausedUntil uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakeOutflowPausedKey(tokenContract), sdk.Uint64ToBigEndian(pausedUntil))
+}
+
+// IterateOutflowPauses iterates over the heights until which tokens are or were paused
+func (k Keeper) IterateOutflowPauses(ctx sdk.Context, cb func(*types.OutflowPause) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.OutflowPausedKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		pause := &types.OutflowPause{
+			TokenContract: common.BytesToAddress(iter.Key()[1:]).Hex(),
+			PausedUntil:   binary.BigEndian.Uint64(iter.Value()),
+		}
+		if cb(pause) {
+			break
+		}
+	}
+}
+
+// IterateOutflows iterates over the amounts of each token batched for Ethereum by height
+func (k Keeper) IterateOutflows(ctx sdk.Context, cb func(*types.WindowAmount) bool) {
+	k.iterateWindowAmounts(ctx, types.OutflowKey, cb)
+}
+
+// iterateWindowAmounts iterates over the amounts recorded under a window key prefix
+func (k Keeper) iterateWindowAmounts(ctx sdk.Context, keyPrefix byte, cb func(*types.WindowAmount) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{keyPrefix})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[1:]
+		amount := &types.WindowAmount{
+			TokenContract: common.BytesToAddress(key[:common.AddressLength]).Hex(),
+			Height:        binary.BigEndian.Uint64(key[common.AddressLength:]),
+			Amount:        unmarshalWindowAmount(iter.Value()),
+		}
+		if cb(amount) {
+			break
+		}
+	}
+}
+
+// setWindowAmount restores an amount recorded under makeKey
+func (k Keeper) setWindowAmount(ctx sdk.Context, makeKey func(common.Address, uint64) []byte, amount *types.WindowAmount) {
+	bz, err := amount.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(makeKey(common.HexToAddress(amount.TokenContract), amount.Height), bz)
+}
+
+// sumWindow returns the amounts of a token recorded under makeKey within the last
+// window blocks, the current one included
+func (k Keeper) sumWindow(ctx sdk.Context, makeKey func(common.Address, uint64) []byte, tokenContract common.Address, window uint64) sdk.Int {
+	sum := sdk.ZeroInt()
+	iter := ctx.KVStore(k.storeKey).Iterator(
+		makeKey(tokenContract, windowStart(ctx, window)),
+		makeKey(tokenContract, uint64(ctx.BlockHeight())+1),
+	)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		sum = sum.Add(unmarshalWindowAmount(iter.Value()))
+	}
+	return sum
+}
+
+// addToWindow records an amount of a token under makeKey at the given height and
+// drops the amounts that fell out of the window
+func (k Keeper) addToWindow(ctx sdk.Context, makeKey func(common.Address, uint64) []byte, tokenContract common.Address, height uint64, amount sdk.Int, window uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := store.Iterator(makeKey(tokenContract, 0), makeKey(tokenContract, windowStart(ctx, window)))
+	var expired [][]byte
+	for ; iter.Valid(); iter.Next() {
+		expired = append(expired, iter.Key())
+	}
+	iter.Close()
+	for _, key := range expired {
+		store.Delete(key)
+	}
+
+	key := makeKey(tokenContract, height)
+	sum := amount
+	if bz := store.Get(key); bz != nil {
+		sum = sum.Add(unmarshalWindowAmount(bz))
+	}
+	if !sum.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	bz, err := sum.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+func windowStart(ctx sdk.Context, window uint64) uint64 {
+	height := uint64(ctx.BlockHeight())
+	if height < window {
+		return 0
+	}
+	return height - window + 1
+}
+
+func unmarshalWindowAmount(bz []byte) sdk.Int {
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
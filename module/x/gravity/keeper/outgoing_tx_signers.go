@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// OutgoingTxSigner is a bonded or unbonding validator whose signatures over outgoing txs are counted
+type OutgoingTxSigner struct {
+	Validator stakingtypes.Validator
+	// whether x/slashing has a signing info for the validator, whose start height is when it joined
+	HasSigningInfo bool
+	StartHeight    int64
+	Unbonding      bool
+}
+
+// GetOutgoingTxSigners returns the bonded validators, then the unbonding validators, whose signatures
+// over outgoing txs are counted
+func (k Keeper) GetOutgoingTxSigners(ctx sdk.Context) ([]OutgoingTxSigner, error) {
+	var signers []OutgoingTxSigner
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get consensus address: %s", err)
+		}
+
+		sigs, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		signers = append(signers, OutgoingTxSigner{val, exist, sigs.StartHeight, false})
+	}
+
+	blockTime := ctx.BlockTime().Add(k.StakingKeeper.GetParams(ctx).UnbondingTime)
+	blockHeight := ctx.BlockHeight()
+	unbondingValIterator := k.StakingKeeper.ValidatorQueueIterator(ctx, blockTime, blockHeight)
+	defer unbondingValIterator.Close()
+
+	// All unbonding validators
+	for ; unbondingValIterator.Valid(); unbondingValIterator.Next() {
+		unbondingValidators := k.GetUnbondingvalidators(unbondingValIterator.Value())
+		for _, valAddr := range unbondingValidators.Addresses {
+			addr, err := sdk.ValAddressFromBech32(valAddr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to bech32 decode validator address: %s", err)
+			}
+
+			validator, _ := k.StakingKeeper.GetValidator(ctx, addr)
+
+			valConsAddr, err := validator.GetConsAddr()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get validator consensus address: %s", err)
+			}
+
+			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, valConsAddr)
+			signers = append(signers, OutgoingTxSigner{validator, exist, valSigningInfo.StartHeight, true})
+		}
+	}
+	return signers, nil
+}
+
+// ExpectedToSign returns whether the signature of the validator over an outgoing tx is counted
+func (s OutgoingTxSigner) ExpectedToSign(otx types.OutgoingTx, unbondSlashingSignerSetTxsWindow uint64) bool {
+	// Don't count validators who joined after outgoingtx is created
+	if !s.HasSigningInfo || s.StartHeight >= int64(otx.GetCosmosHeight()) {
+		return false
+	}
+	if !s.Unbonding {
+		return true
+	}
+
+	// Only count unbonding validators for signer sets created before UNBOND_SLASHING_WINDOW passed
+	sstx, ok := otx.(*types.SignerSetTx)
+	return ok && s.Validator.IsUnbonding() &&
+		sstx.Height < uint64(s.Validator.UnbondingHeight)+unbondSlashingSignerSetTxsWindow
+}
+
+// slashingPreview returns the outgoing txs a validator has not signed yet among the ones whose missing
+// signatures are not counted yet, and the penalties it would get if it signs none of them in time
+func (k Keeper) slashingPreview(ctx sdk.Context, signer OutgoingTxSigner, otxs []types.OutgoingTx) *types.ValidatorSlashingPreview {
+	params := k.GetParams(ctx)
+	valAddr := signer.Validator.GetOperator()
+	preview := &types.ValidatorSlashingPreview{ValidatorAddress: valAddr.String()}
+
+	info := k.GetBridgeSigningInfo(ctx, valAddr)
+	if info == nil {
+		info = &types.BridgeSigningInfo{}
+	}
+	jailed := k.StakingKeeper.Validator(ctx, valAddr).IsJailed()
+
+	for _, otx := range otxs {
+		if !signer.ExpectedToSign(otx, params.UnbondSlashingSignerSetTxsWindow) {
+			continue
+		}
+		if _, signed := k.GetEthereumSignatures(ctx, otx.GetStoreIndex())[valAddr.String()]; signed {
+			recordOutgoingTxSignature(info, params.BridgeSigningWindow, types.OutgoingTxType_OUTGOING_TX_TYPE_UNSPECIFIED)
+			continue
+		}
+
+		txType := outgoingTxType(otx)
+		recordOutgoingTxSignature(info, params.BridgeSigningWindow, txType)
+		unsigned := &types.UnsignedOutgoingTx{
+			Type:              txType,
+			Nonce:             outgoingTxNonce(otx),
+			StoreIndex:        otx.GetStoreIndex(),
+			CosmosHeight:      otx.GetCosmosHeight(),
+			SlashableHeight:   otx.GetCosmosHeight() + params.SignedBatchesWindow + 1,
+			MissedOutgoingTxs: info.MissedSignerSetTxs + info.MissedBatchTxs + info.MissedContractCallTxs,
+			SlashFraction:     sdk.ZeroDec(),
+		}
+		if !jailed && bridgeSigningMissedRatioExceeded(info, params) {
+			unsigned.Jailed = true
+			unsigned.SlashFraction, unsigned.Reason = outgoingTxPenalty(params, txType)
+			resetBridgeSigningInfo(info)
+			jailed = true
+		}
+		preview.UnsignedOutgoingTxs = append(preview.UnsignedOutgoingTxs, unsigned)
+	}
+	return preview
+}
+
+func outgoingTxNonce(otx types.OutgoingTx) uint64 {
+	switch otx := otx.(type) {
+	case *types.SignerSetTx:
+		return otx.Nonce
+	case *types.BatchTx:
+		return otx.BatchNonce
+	case *types.ContractCallTx:
+		return otx.InvalidationNonce
+	default:
+		panic(fmt.Sprintf("unknown outgoing tx type %T", otx))
+	}
+}
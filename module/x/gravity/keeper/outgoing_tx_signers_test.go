@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestSlashingPreview(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	params := gk.GetParams(ctx)
+	params.BridgeSigningWindow = 2
+	gk.SetParams(ctx, params)
+
+	height := uint64(ctx.BlockHeight())
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	var batches []*types.BatchTx
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		batch := &types.BatchTx{
+			BatchNonce:    nonce,
+			TokenContract: TokenContractAddrs[0],
+			Height:        height + nonce,
+		}
+		gk.SetOutgoingTx(ctx, batch)
+		batches = append(batches, batch)
+
+		// the first validator signs nothing
+		for i := 1; i < len(ValAddrs); i++ {
+			gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+				BatchNonce:     nonce,
+				TokenContract:  TokenContractAddrs[0],
+				EthereumSigner: EthAddrs[i].String(),
+				Signature:      []byte("dummysig"),
+			}, ValAddrs[i])
+		}
+	}
+
+	res, err := gk.SlashingPreview(sdk.WrapSDKContext(ctx), &types.SlashingPreviewRequest{})
+	require.NoError(t, err)
+	require.True(t, res.BridgeActive)
+	require.Len(t, res.Validators, 1)
+	preview := res.Validators[0]
+	require.Equal(t, ValAddrs[0].String(), preview.ValidatorAddress)
+	require.Len(t, preview.UnsignedOutgoingTxs, 2)
+
+	// the first missed batch does not fill the window yet
+	first := preview.UnsignedOutgoingTxs[0]
+	require.Equal(t, types.OutgoingTxType_OUTGOING_TX_TYPE_BATCH, first.Type)
+	require.Equal(t, uint64(1), first.Nonce)
+	require.Equal(t, batches[0].GetStoreIndex(), first.StoreIndex)
+	require.Equal(t, batches[0].Height+params.SignedBatchesWindow+1, first.SlashableHeight)
+	require.Equal(t, uint64(1), first.MissedOutgoingTxs)
+	require.False(t, first.Jailed)
+	require.True(t, first.SlashFraction.IsZero())
+
+	second := preview.UnsignedOutgoingTxs[1]
+	require.Equal(t, uint64(2), second.MissedOutgoingTxs)
+	require.True(t, second.Jailed)
+	require.Equal(t, params.SlashFractionBatch, second.SlashFraction)
+	require.Equal(t, types.AttributeMissingBridgeBatchSig, second.Reason)
+
+	// the preview changes nothing
+	require.Nil(t, gk.GetBridgeSigningInfo(ctx, ValAddrs[0]))
+
+	res, err = gk.SlashingPreview(sdk.WrapSDKContext(ctx), &types.SlashingPreviewRequest{ValidatorAddress: ValAddrs[1].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 1)
+	require.Empty(t, res.Validators[0].UnsignedOutgoingTxs)
+
+	_, err = gk.SlashingPreview(sdk.WrapSDKContext(ctx), &types.SlashingPreviewRequest{ValidatorAddress: "invalid"})
+	require.Error(t, err)
+}
@@ -3,12 +3,15 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"sort"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
@@ -30,6 +33,10 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 		return 0, err
 	}
 
+	if err := k.checkSendToEthereum(ctx, tokenContract, counterpartReceiver, fee.Amount, totalAmount.Amount); err != nil {
+		return 0, err
+	}
+
 	if senderModule, ok := k.SenderModuleAccounts[sender.String()]; ok {
 		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, totalInVouchers); err != nil {
 			return 0, err
@@ -40,6 +47,26 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 		}
 	}
 
+	return k.poolSendToEthereum(ctx, sender, counterpartReceiver, tokenContract, amount.Amount, fee.Amount), nil
+}
+
+// checkSendToEthereum rejects a new send to ethereum to a denied recipient, paying less than the
+// minimum bridge fee of its token, or going over the outflow limit of its token
+func (k Keeper) checkSendToEthereum(ctx sdk.Context, tokenContract common.Address, recipient string, fee, total sdk.Int) error {
+	if err := k.checkEthereumRecipient(ctx, common.HexToAddress(recipient)); err != nil {
+		return err
+	}
+
+	if minFee, found := k.GetMinBridgeFee(ctx, tokenContract); found && fee.LT(minFee) {
+		return sdkerrors.Wrapf(types.ErrBridgeFeeTooLow, "%s is less than %s", fee, minFee)
+	}
+
+	return k.checkOutflow(ctx, tokenContract, total)
+}
+
+// poolSendToEthereum adds a send to ethereum, whose tokens the module already holds, to the pool
+// and returns its id
+func (k Keeper) poolSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, recipient string, tokenContract common.Address, amount, fee sdk.Int) uint64 {
 	// get next tx id from keeper
 	nextID := k.incrementLastSendToEthereumIDKey(ctx)
 
@@ -51,12 +78,22 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 	k.setUnbatchedSendToEthereum(ctx, &types.SendToEthereum{
 		Id:                nextID,
 		Sender:            sender.String(),
-		EthereumRecipient: counterpartReceiver,
-		Erc20Token:        types.NewSDKIntERC20Token(amount.Amount, tokenContract),
-		Erc20Fee:          types.NewSDKIntERC20Token(fee.Amount, tokenContract),
+		EthereumRecipient: recipient,
+		Erc20Token:        types.NewSDKIntERC20Token(amount, tokenContract),
+		Erc20Fee:          types.NewSDKIntERC20Token(fee, tokenContract),
 	})
 
-	return nextID, nil
+	return nextID
+}
+
+// GetMinBridgeFee returns the minimum bridge fee of a token, if governance set one
+func (k Keeper) GetMinBridgeFee(ctx sdk.Context, tokenContract common.Address) (sdk.Int, bool) {
+	for _, minFee := range k.GetParams(ctx).MinBridgeFees {
+		if common.HexToAddress(minFee.Contract) == tokenContract {
+			return minFee.Amount, true
+		}
+	}
+	return sdk.Int{}, false
 }
 
 // cancelSendToEthereum
@@ -66,12 +103,7 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error {
 	sender, _ := sdk.AccAddressFromBech32(s)
 
-	var send *types.SendToEthereum
-	for _, ste := range k.getUnbatchedSendToEthereums(ctx) {
-		if ste.Id == id {
-			send = ste
-		}
-	}
+	send := k.getUnbatchedSendToEthereum(ctx, id)
 	if send == nil {
 		// NOTE: this case will also be hit if the transaction is in a batch
 		return sdkerrors.Wrap(types.ErrInvalid, "id not found in send to ethereum pool")
@@ -81,24 +113,296 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 		return fmt.Errorf("can't cancel a message you didn't send")
 	}
 
+	if err := k.refundSendToEthereum(ctx, send); err != nil {
+		return err
+	}
+
+	k.setSendToEthereumState(ctx, id, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_CANCELED)
+	return nil
+}
+
+// refundSendToEthereum
+// - issues the tokens and fee of an unbatched tx back to the sender
+// - deletes the unbatched tx from the pool
+func (k Keeper) refundSendToEthereum(ctx sdk.Context, send *types.SendToEthereum) error {
+	sender, err := sdk.AccAddressFromBech32(send.Sender)
+	if err != nil {
+		return err
+	}
+
 	_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(send.Erc20Token.Contract))
 	amountToRefund := send.Erc20Token.Amount.Add(send.Erc20Fee.Amount)
 	coinsToRefund := sdk.NewCoins(sdk.NewCoin(denom, amountToRefund))
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coinsToRefund); err != nil {
-		return sdkerrors.Wrap(err, "sending coins from module account")
+	if senderModule, ok := k.SenderModuleAccounts[send.Sender]; ok {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, senderModule, coinsToRefund); err != nil {
+			return sdkerrors.Wrap(err, "sending coins from module account")
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coinsToRefund); err != nil {
+			return sdkerrors.Wrap(err, "sending coins from module account")
+		}
 	}
 
-	k.deleteUnbatchedSendToEthereum(ctx, send.Id, send.Erc20Fee)
+	k.deleteUnbatchedSendToEthereum(ctx, send)
+	k.deleteSendToEthereumRecords(ctx, send.Id)
 	return nil
 }
 
+// RefundStaleSendToEthereum refunds an unbatched tx that reached the SendToEthereumMaxPoolAge or
+// SendToEthereumMaxBatchTimeouts limit, or whose recipient was denied. Quarantined deposits returned
+// by governance have no sender to refund to, so they go back to quarantine instead. A refund that
+// fails leaves the tx in the pool.
+func (k Keeper) RefundStaleSendToEthereum(ctx sdk.Context, send *types.SendToEthereum, reason string) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	refund := k.refundSendToEthereum
+	if send.Sender == authtypes.NewModuleAddress(types.ModuleName).String() {
+		refund = k.requarantineSendToEthereum
+	}
+	if err := refund(cacheCtx, send); err != nil {
+		k.Logger(ctx).Error("failed to refund send to ethereum",
+			"id", send.Id,
+			"reason", reason,
+			"error", err)
+		return
+	}
+	writeCache()
+
+	k.updateSendToEthereumStatus(ctx, send.Id, func(status *types.SendToEthereumStatus) {
+		status.State = types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_REFUNDED
+		status.RefundReason = reason
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeWithdrawRefunded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(send.Id)),
+			sdk.NewAttribute(types.AttributeKeySender, send.Sender),
+			sdk.NewAttribute(types.AttributeKeyRefundReason, reason),
+		),
+	)
+}
+
+// increaseBridgeFee
+// - checks that the provided tx actually exists and belongs to the sender
+// - checks that the fee increase maps to the tx's token contract
+// - transfers the fee increase from the sender to the module
+// - re-keys the unbatched tx in the pool under its new fee
+func (k Keeper) increaseBridgeFee(ctx sdk.Context, id uint64, s string, feeIncrease sdk.Coin) (*types.SendToEthereum, error) {
+	sender, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return nil, err
+	}
+
+	send := k.getUnbatchedSendToEthereum(ctx, id)
+	if send == nil {
+		// NOTE: this case will also be hit if the transaction is in a batch
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "id not found in send to ethereum pool")
+	}
+
+	if sender.String() != send.Sender {
+		return nil, fmt.Errorf("can't increase the fee of a message you didn't send")
+	}
+
+	_, tokenContract, err := k.DenomToERC20Lookup(ctx, feeIncrease.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if tokenContract != common.HexToAddress(send.Erc20Fee.Contract) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "fee increase denom %s does not match token contract %s", feeIncrease.Denom, send.Erc20Fee.Contract)
+	}
+
+	feeCoins := sdk.Coins{feeIncrease}
+	if senderModule, ok := k.SenderModuleAccounts[sender.String()]; ok {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, feeCoins); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, feeCoins); err != nil {
+			return nil, err
+		}
+	}
+
+	// the pool key contains the fee, so the tx has to be removed under its
+	// old key before it is stored again with the increased fee
+	k.deleteUnbatchedSendToEthereum(ctx, send)
+	send.Erc20Fee = types.NewSDKIntERC20Token(send.Erc20Fee.Amount.Add(feeIncrease.Amount), tokenContract)
+	k.setUnbatchedSendToEthereum(ctx, send)
+
+	return send, nil
+}
+
+func (k Keeper) getUnbatchedSendToEthereum(ctx sdk.Context, id uint64) *types.SendToEthereum {
+	store := ctx.KVStore(k.storeKey)
+	key := store.Get(types.MakeSendToEthereumByIDKey(id))
+	if key == nil {
+		return nil
+	}
+	var send types.SendToEthereum
+	k.cdc.MustUnmarshal(store.Get(key), &send)
+	return &send
+}
+
 func (k Keeper) setUnbatchedSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
-	ctx.KVStore(k.storeKey).Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), k.cdc.MustMarshal(ste))
+	store := ctx.KVStore(k.storeKey)
+	key := types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee)
+	store.Set(key, k.cdc.MustMarshal(ste))
+
+	// a tx returned to the pool from a canceled batch keeps the height it first entered the pool at
+	height, found := k.getSendToEthereumHeight(ctx, ste.Id)
+	if !found {
+		height = uint64(ctx.BlockHeight())
+		store.Set(types.MakeSendToEthereumHeightKey(ste.Id), sdk.Uint64ToBigEndian(height))
+	}
+	store.Set(types.MakeSendToEthereumPoolHeightKey(height, ste.Id), key)
+
+	store.Set(types.MakeSendToEthereumByIDKey(ste.Id), key)
+	store.Set(types.MakeSendToEthereumBySenderKey(sdk.MustAccAddressFromBech32(ste.Sender), ste.Id), key)
+	store.Set(types.MakeSendToEthereumByRecipientKey(common.HexToAddress(ste.EthereumRecipient), ste.Id), key)
+	store.Set(types.MakeSendToEthereumByContractKey(common.HexToAddress(ste.Erc20Token.Contract), ste.Id), key)
+
+	k.setSendToEthereumState(ctx, ste.Id, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_POOLED)
+}
+
+func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee))
+	if height, found := k.getSendToEthereumHeight(ctx, ste.Id); found {
+		store.Delete(types.MakeSendToEthereumPoolHeightKey(height, ste.Id))
+	}
+
+	store.Delete(types.MakeSendToEthereumByIDKey(ste.Id))
+	store.Delete(types.MakeSendToEthereumBySenderKey(sdk.MustAccAddressFromBech32(ste.Sender), ste.Id))
+	store.Delete(types.MakeSendToEthereumByRecipientKey(common.HexToAddress(ste.EthereumRecipient), ste.Id))
+	store.Delete(types.MakeSendToEthereumByContractKey(common.HexToAddress(ste.Erc20Token.Contract), ste.Id))
+}
+
+func (k Keeper) getSendToEthereumHeight(ctx sdk.Context, id uint64) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeSendToEthereumHeightKey(id))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// GetSendToEthereumBatchTimeouts returns the number of timed out batches a tx was part of
+func (k Keeper) GetSendToEthereumBatchTimeouts(ctx sdk.Context, id uint64) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeSendToEthereumBatchTimeoutsKey(id))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// IncrementSendToEthereumBatchTimeouts counts a timed out batch against a tx and returns the new count
+func (k Keeper) IncrementSendToEthereumBatchTimeouts(ctx sdk.Context, id uint64) uint64 {
+	timeouts := k.GetSendToEthereumBatchTimeouts(ctx, id) + 1
+	ctx.KVStore(k.storeKey).Set(types.MakeSendToEthereumBatchTimeoutsKey(id), sdk.Uint64ToBigEndian(timeouts))
+	k.updateSendToEthereumStatus(ctx, id, func(status *types.SendToEthereumStatus) {
+		status.BatchTimeouts = timeouts
+	})
+	return timeouts
+}
+
+// deleteSendToEthereumRecords deletes the pool height and batch timeout count of a tx
+// once it has left the bridge, either executed on Ethereum or refunded
+func (k Keeper) deleteSendToEthereumRecords(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MakeSendToEthereumHeightKey(id))
+	store.Delete(types.MakeSendToEthereumBatchTimeoutsKey(id))
+	store.Delete(types.MakeReturnedQuarantinedDepositKey(id))
+}
+
+// IterateSendToEthereumPoolRecords iterates, by id, over the pool heights and batch timeout counts
+// of the txs that have not left the bridge yet
+func (k Keeper) IterateSendToEthereumPoolRecords(ctx sdk.Context, cb func(*types.SendToEthereumPoolRecord) bool) {
+	store := ctx.KVStore(k.storeKey)
+	var records []*types.SendToEthereumPoolRecord
+	byID := map[uint64]*types.SendToEthereumPoolRecord{}
+	record := func(id uint64) *types.SendToEthereumPoolRecord {
+		if byID[id] == nil {
+			byID[id] = &types.SendToEthereumPoolRecord{Id: id}
+			records = append(records, byID[id])
+		}
+		return byID[id]
+	}
+
+	heightIter := sdk.KVStorePrefixIterator(store, []byte{types.SendToEthereumHeightKey})
+	defer heightIter.Close()
+	for ; heightIter.Valid(); heightIter.Next() {
+		record(binary.BigEndian.Uint64(heightIter.Key()[1:])).Height = binary.BigEndian.Uint64(heightIter.Value())
+	}
+	timeoutsIter := sdk.KVStorePrefixIterator(store, []byte{types.SendToEthereumBatchTimeoutsKey})
+	defer timeoutsIter.Close()
+	for ; timeoutsIter.Valid(); timeoutsIter.Next() {
+		record(binary.BigEndian.Uint64(timeoutsIter.Key()[1:])).BatchTimeouts = binary.BigEndian.Uint64(timeoutsIter.Value())
+	}
+
+	sort.Slice(records, func(i, j int) bool { return records[i].Id < records[j].Id })
+	for _, r := range records {
+		if cb(r) {
+			break
+		}
+	}
+}
+
+// setSendToEthereumPoolRecord restores the pool height and batch timeout count of a tx
+func (k Keeper) setSendToEthereumPoolRecord(ctx sdk.Context, record *types.SendToEthereumPoolRecord) {
+	store := ctx.KVStore(k.storeKey)
+	if record.Height > 0 {
+		store.Set(types.MakeSendToEthereumHeightKey(record.Id), sdk.Uint64ToBigEndian(record.Height))
+	}
+	if record.BatchTimeouts > 0 {
+		store.Set(types.MakeSendToEthereumBatchTimeoutsKey(record.Id), sdk.Uint64ToBigEndian(record.BatchTimeouts))
+	}
+}
+
+// iterateUnbatchedSendToEthereumsByIndex iterates, by id, over the unbatched txs whose keys are
+// indexed under the given prefix
+func (k Keeper) iterateUnbatchedSendToEthereumsByIndex(ctx sdk.Context, indexPrefix []byte, cb func(*types.SendToEthereum) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, indexPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ste types.SendToEthereum
+		k.cdc.MustUnmarshal(store.Get(iter.Value()), &ste)
+		if cb(&ste) {
+			break
+		}
+	}
+}
+
+// IterateUnbatchedSendToEthereumsBySender iterates, by id, over the unbatched txs of a sender
+func (k Keeper) IterateUnbatchedSendToEthereumsBySender(ctx sdk.Context, sender sdk.AccAddress, cb func(*types.SendToEthereum) bool) {
+	k.iterateUnbatchedSendToEthereumsByIndex(ctx, types.MakeSendToEthereumBySenderPrefix(sender), cb)
+}
+
+// IterateUnbatchedSendToEthereumsByRecipient iterates, by id, over the unbatched txs to an ethereum recipient
+func (k Keeper) IterateUnbatchedSendToEthereumsByRecipient(ctx sdk.Context, recipient common.Address, cb func(*types.SendToEthereum) bool) {
+	k.iterateUnbatchedSendToEthereumsByIndex(ctx, types.MakeSendToEthereumByRecipientPrefix(recipient), cb)
+}
+
+// IterateUnbatchedSendToEthereumsByPoolHeight iterates, oldest first, over the unbatched txs
+// that entered the pool at or before maxHeight
+func (k Keeper) IterateUnbatchedSendToEthereumsByPoolHeight(ctx sdk.Context, maxHeight uint64, cb func(*types.SendToEthereum) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte{types.SendToEthereumPoolHeightKey}, types.MakeSendToEthereumPoolHeightKey(maxHeight+1, 0))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ste types.SendToEthereum
+		k.cdc.MustUnmarshal(store.Get(iter.Value()), &ste)
+		if cb(&ste) {
+			break
+		}
+	}
 }
 
-func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, id uint64, fee types.ERC20Token) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeSendToEthereumKey(id, fee))
+// iterateUnbatchedSendToEthereumsByAge iterates, oldest first, over the unbatched txs of a token
+func (k Keeper) iterateUnbatchedSendToEthereumsByAge(ctx sdk.Context, contract common.Address, cb func(*types.SendToEthereum) bool) {
+	k.iterateUnbatchedSendToEthereumsByIndex(ctx, types.MakeSendToEthereumByContractPrefix(contract), cb)
 }
 
 func (k Keeper) iterateUnbatchedSendToEthereumsByContract(ctx sdk.Context, contract common.Address, cb func(*types.SendToEthereum) bool) {
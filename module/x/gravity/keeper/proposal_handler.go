@@ -2,8 +2,10 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
 
@@ -32,3 +34,40 @@ func (k Keeper) HandleCommunityPoolEthereumSpendProposal(ctx sdk.Context, p *typ
 
 	return nil
 }
+
+func (k Keeper) HandleReleaseQuarantinedDepositsProposal(ctx sdk.Context, p *types.ReleaseQuarantinedDepositsProposal) error {
+	for _, id := range p.Ids {
+		if err := k.releaseQuarantinedDeposit(ctx, id); err != nil {
+			return err
+		}
+	}
+	k.Logger(ctx).Info("quarantined deposits released", "ids", p.Ids)
+	return nil
+}
+
+func (k Keeper) HandleReturnQuarantinedDepositsProposal(ctx sdk.Context, p *types.ReturnQuarantinedDepositsProposal) error {
+	for _, id := range p.Ids {
+		if err := k.returnQuarantinedDeposit(ctx, id); err != nil {
+			return err
+		}
+	}
+	k.Logger(ctx).Info("quarantined deposits returned to Ethereum", "ids", p.Ids)
+	return nil
+}
+
+func (k Keeper) HandleAddEthereumDenylistProposal(ctx sdk.Context, p *types.AddEthereumDenylistProposal) error {
+	k.updateEthereumDenylist(ctx, p.Addresses, true)
+	k.Logger(ctx).Info("ethereum addresses denylisted", "addresses", p.Addresses)
+	return nil
+}
+
+func (k Keeper) HandleRemoveEthereumDenylistProposal(ctx sdk.Context, p *types.RemoveEthereumDenylistProposal) error {
+	for _, address := range p.Addresses {
+		if !k.IsEthereumDenylisted(ctx, common.HexToAddress(address)) {
+			return sdkerrors.Wrapf(types.ErrInvalid, "%s is not denylisted", address)
+		}
+	}
+	k.updateEthereumDenylist(ctx, p.Addresses, false)
+	k.Logger(ctx).Info("ethereum addresses removed from the denylist", "addresses", p.Addresses)
+	return nil
+}
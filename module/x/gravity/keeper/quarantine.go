@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// getInflowLimit returns the inflow limit of a token, if governance set one
+func (k Keeper) getInflowLimit(ctx sdk.Context, tokenContract common.Address) (types.InflowLimit, bool) {
+	for _, limit := range k.GetParams(ctx).InflowLimits {
+		if common.HexToAddress(limit.TokenContract) == tokenContract {
+			return limit, true
+		}
+	}
+	return types.InflowLimit{}, false
+}
+
+// GetInflow returns the amount of a token deposited from Ethereum within the last
+// window blocks, the current one included
+func (k Keeper) GetInflow(ctx sdk.Context, tokenContract common.Address, window uint64) sdk.Int {
+	return k.sumWindow(ctx, types.MakeInflowKey, tokenContract, window)
+}
+
+// IterateInflows iterates over the amounts of each token deposited from Ethereum by height
+func (k Keeper) IterateInflows(ctx sdk.Context, cb func(*types.WindowAmount) bool) {
+	k.iterateWindowAmounts(ctx, types.InflowKey, cb)
+}
+
+// recordInflow counts a deposit against the inflow limit of its token. A deposit
+// that would go over the limit is not counted and is quarantined instead.
+func (k Keeper) recordInflow(ctx sdk.Context, event *types.SendToCosmosEvent) (quarantined bool) {
+	tokenContract := common.HexToAddress(event.TokenContract)
+	limit, found := k.getInflowLimit(ctx, tokenContract)
+	if !found {
+		return false
+	}
+
+	inflow := k.GetInflow(ctx, tokenContract, limit.Window)
+	if inflow.Add(event.Amount).GT(limit.Limit) {
+		k.quarantineDeposit(ctx, event, inflow, limit)
+		return true
+	}
+
+	k.addToWindow(ctx, types.MakeInflowKey, tokenContract, uint64(ctx.BlockHeight()), event.Amount, limit.Window)
+	return false
+}
+
+// quarantineDeposit records a deposit whose tokens stay in the module account
+// until governance releases or returns them
+func (k Keeper) quarantineDeposit(ctx sdk.Context, event *types.SendToCosmosEvent, inflow sdk.Int, limit types.InflowLimit) {
+	deposit := &types.QuarantinedDeposit{
+		Id:      k.incrementLastQuarantinedDepositID(ctx),
+		Deposit: event,
+		Height:  uint64(ctx.BlockHeight()),
+		Status:  types.QuarantineStatus_QUARANTINE_STATUS_QUARANTINED,
+	}
+	k.setQuarantinedDeposit(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositQuarantined,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyQuarantinedDepositID, fmt.Sprint(deposit.Id)),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, common.HexToAddress(event.TokenContract).Hex()),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+			sdk.NewAttribute(types.AttributeKeyInflow, inflow.String()),
+			sdk.NewAttribute(types.AttributeKeyInflowLimit, limit.Limit.String()),
+		),
+	)
+}
+
+// releaseQuarantinedDeposit credits a quarantined deposit to its cosmos receiver
+func (k Keeper) releaseQuarantinedDeposit(ctx sdk.Context, id uint64) error {
+	deposit, err := k.getPendingQuarantinedDeposit(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// a blocked receiver would leave the coins in the module account, the deposit is returned instead
+	if _, ok := k.ReceiverModuleAccounts[deposit.Deposit.CosmosReceiver]; !ok {
+		addr, _ := sdk.AccAddressFromBech32(deposit.Deposit.CosmosReceiver)
+		if k.bankKeeper.BlockedAddr(addr) {
+			return sdkerrors.Wrapf(types.ErrQuarantinedDeposit, "id %d is to the blocked address %s", id, deposit.Deposit.CosmosReceiver)
+		}
+	}
+
+	_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(deposit.Deposit.TokenContract))
+	coins := sdk.Coins{sdk.NewCoin(denom, deposit.Deposit.Amount)}
+	if err := k.creditSendToCosmos(ctx, deposit.Deposit, coins); err != nil {
+		return err
+	}
+
+	deposit.Status = types.QuarantineStatus_QUARANTINE_STATUS_RELEASED
+	k.setQuarantinedDeposit(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuarantineReleased,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyQuarantinedDepositID, fmt.Sprint(deposit.Id)),
+		),
+	)
+	return nil
+}
+
+// returnQuarantinedDeposit sends a quarantined deposit back to its ethereum sender.
+// The tokens are already held by the module account, so the send to ethereum is
+// added to the pool on behalf of the module account. It pays the minimum bridge fee
+// of its token out of the deposit and goes through the same checks as any other
+// send to ethereum.
+func (k Keeper) returnQuarantinedDeposit(ctx sdk.Context, id uint64) error {
+	deposit, err := k.getPendingQuarantinedDeposit(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	tokenContract := common.HexToAddress(deposit.Deposit.TokenContract)
+	fee := sdk.ZeroInt()
+	if minFee, found := k.GetMinBridgeFee(ctx, tokenContract); found {
+		fee = minFee
+	}
+	if !deposit.Deposit.Amount.GT(fee) {
+		return sdkerrors.Wrapf(types.ErrBridgeFeeTooLow, "deposit %d of %s does not cover the bridge fee of %s", id, deposit.Deposit.Amount, fee)
+	}
+	if err := k.checkSendToEthereum(ctx, tokenContract, deposit.Deposit.EthereumSender, fee, deposit.Deposit.Amount); err != nil {
+		return err
+	}
+
+	txID := k.poolSendToEthereum(ctx, authtypes.NewModuleAddress(types.ModuleName), deposit.Deposit.EthereumSender,
+		tokenContract, deposit.Deposit.Amount.Sub(fee), fee)
+	ctx.KVStore(k.storeKey).Set(types.MakeReturnedQuarantinedDepositKey(txID), sdk.Uint64ToBigEndian(deposit.Id))
+
+	deposit.Status = types.QuarantineStatus_QUARANTINE_STATUS_RETURNED
+	deposit.SendToEthereumId = txID
+	k.setQuarantinedDeposit(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuarantineReturned,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyQuarantinedDepositID, fmt.Sprint(deposit.Id)),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+		),
+	)
+	return nil
+}
+
+// requarantineSendToEthereum takes a returned quarantined deposit that could not leave the pool
+// back into quarantine, where governance can release or return it again
+func (k Keeper) requarantineSendToEthereum(ctx sdk.Context, send *types.SendToEthereum) error {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeReturnedQuarantinedDepositKey(send.Id))
+	if bz == nil {
+		return sdkerrors.Wrapf(types.ErrQuarantinedDeposit, "no deposit returned by send to ethereum %d", send.Id)
+	}
+	deposit := k.GetQuarantinedDeposit(ctx, binary.BigEndian.Uint64(bz))
+	if deposit == nil {
+		return sdkerrors.Wrapf(types.ErrQuarantinedDeposit, "id %d not found", binary.BigEndian.Uint64(bz))
+	}
+
+	deposit.Status = types.QuarantineStatus_QUARANTINE_STATUS_QUARANTINED
+	deposit.SendToEthereumId = 0
+	k.setQuarantinedDeposit(ctx, deposit)
+
+	k.deleteUnbatchedSendToEthereum(ctx, send)
+	k.deleteSendToEthereumRecords(ctx, send.Id)
+	return nil
+}
+
+func (k Keeper) getPendingQuarantinedDeposit(ctx sdk.Context, id uint64) (*types.QuarantinedDeposit, error) {
+	deposit := k.GetQuarantinedDeposit(ctx, id)
+	if deposit == nil {
+		return nil, sdkerrors.Wrapf(types.ErrQuarantinedDeposit, "id %d not found", id)
+	}
+	if deposit.Status != types.QuarantineStatus_QUARANTINE_STATUS_QUARANTINED {
+		return nil, sdkerrors.Wrapf(types.ErrQuarantinedDeposit, "id %d is %s", id, deposit.Status)
+	}
+	return deposit, nil
+}
+
+// GetQuarantinedDeposit returns a quarantined deposit by id
+func (k Keeper) GetQuarantinedDeposit(ctx sdk.Context, id uint64) *types.QuarantinedDeposit {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeQuarantinedDepositKey(id))
+	if bz == nil {
+		return nil
+	}
+	var deposit types.QuarantinedDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return &deposit
+}
+
+func (k Keeper) setQuarantinedDeposit(ctx sdk.Context, deposit *types.QuarantinedDeposit) {
+	ctx.KVStore(k.storeKey).Set(types.MakeQuarantinedDepositKey(deposit.Id), k.cdc.MustMarshal(deposit))
+}
+
+// IterateQuarantinedDeposits iterates over all quarantined deposits, whatever their status, by id
+func (k Keeper) IterateQuarantinedDeposits(ctx sdk.Context, cb func(*types.QuarantinedDeposit) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.QuarantinedDepositKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.QuarantinedDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		if cb(&deposit) {
+			break
+		}
+	}
+}
+
+func (k Keeper) incrementLastQuarantinedDepositID(ctx sdk.Context) uint64 {
+	id := k.getLastQuarantinedDepositID(ctx) + 1
+	k.setLastQuarantinedDepositID(ctx, id)
+	return id
+}
+
+func (k Keeper) getLastQuarantinedDepositID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastQuarantinedDepositIDKey})
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastQuarantinedDepositID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastQuarantinedDepositIDKey}, sdk.Uint64ToBigEndian(id))
+}
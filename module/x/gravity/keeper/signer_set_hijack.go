@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// checkSignerSetTxExecutedEvent compares the members of an observed signer set update with the
+// signer set tx created at its nonce, and returns an incident if they differ or if no signer set
+// tx was ever created at that nonce. Signer sets created before their hashes were kept, like the
+// one the contract is deployed with, are accepted as they are.
+func (k Keeper) checkSignerSetTxExecutedEvent(ctx sdk.Context, event *types.SignerSetTxExecutedEvent) *types.SignerSetHijackIncident {
+	observedHash := signerSetHash(event.Members)
+
+	expectedHash := k.GetSignerSetHash(ctx, event.SignerSetTxNonce)
+	switch {
+	case expectedHash == nil && event.SignerSetTxNonce <= k.GetLatestSignerSetTxNonce(ctx):
+		return nil
+	case bytes.Equal(expectedHash, observedHash):
+		return nil
+	}
+
+	return &types.SignerSetHijackIncident{
+		SignerSetNonce:  event.SignerSetTxNonce,
+		ExpectedHash:    expectedHash,
+		ObservedHash:    observedHash,
+		ObservedSigners: event.Members,
+		EventNonce:      event.EventNonce,
+		Height:          uint64(ctx.BlockHeight()),
+	}
+}
+
+// recordSignerSetHijack stores the incident, alerts about it and pauses the bridge until
+// governance turns it back on
+func (k Keeper) recordSignerSetHijack(ctx sdk.Context, incident *types.SignerSetHijackIncident) {
+	k.setSignerSetHijackIncident(ctx, incident)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSignerSetHijacked,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeySignerSetNonce, fmt.Sprint(incident.SignerSetNonce)),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(incident.EventNonce)),
+			sdk.NewAttribute(types.AttributeKeyExpectedHash, hex.EncodeToString(incident.ExpectedHash)),
+			sdk.NewAttribute(types.AttributeKeyObservedHash, hex.EncodeToString(incident.ObservedHash)),
+		),
+	)
+
+	k.Logger(ctx).Error(
+		"observed signer set does not match the created one, disabling the bridge",
+		"signer_set_nonce", incident.SignerSetNonce,
+		"event_nonce", incident.EventNonce,
+		"expected_hash", hex.EncodeToString(incident.ExpectedHash),
+		"observed_hash", hex.EncodeToString(incident.ObservedHash),
+	)
+	k.DisableBridge(ctx)
+}
+
+// signerSetHash hashes a copy of the signers, since hashing sorts them in place
+func signerSetHash(signers types.EthereumSigners) []byte {
+	return append(types.EthereumSigners{}, signers...).Hash()
+}
+
+// GetSignerSetHash returns the hash of the signers of the signer set tx created at a nonce
+func (k Keeper) GetSignerSetHash(ctx sdk.Context, nonce uint64) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.MakeSignerSetHashKey(nonce))
+}
+
+func (k Keeper) setSignerSetHash(ctx sdk.Context, nonce uint64, hash []byte) {
+	ctx.KVStore(k.storeKey).Set(types.MakeSignerSetHashKey(nonce), hash)
+}
+
+// IterateSignerSetHashes iterates over the hashes of all created signer set txs by nonce
+func (k Keeper) IterateSignerSetHashes(ctx sdk.Context, cb func(nonce uint64, hash []byte) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.SignerSetHashKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.BigEndianToUint64(iter.Key()[1:]), iter.Value()) {
+			break
+		}
+	}
+}
+
+// GetSignerSetHijackIncident returns the incident recorded for a signer set nonce
+func (k Keeper) GetSignerSetHijackIncident(ctx sdk.Context, nonce uint64) *types.SignerSetHijackIncident {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeSignerSetHijackIncidentKey(nonce))
+	if bz == nil {
+		return nil
+	}
+	var incident types.SignerSetHijackIncident
+	k.cdc.MustUnmarshal(bz, &incident)
+	return &incident
+}
+
+func (k Keeper) setSignerSetHijackIncident(ctx sdk.Context, incident *types.SignerSetHijackIncident) {
+	ctx.KVStore(k.storeKey).Set(types.MakeSignerSetHijackIncidentKey(incident.SignerSetNonce), k.cdc.MustMarshal(incident))
+}
+
+// IterateSignerSetHijackIncidents iterates over all recorded incidents by signer set nonce
+func (k Keeper) IterateSignerSetHijackIncidents(ctx sdk.Context, cb func(*types.SignerSetHijackIncident) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.SignerSetHijackIncidentKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var incident types.SignerSetHijackIncident
+		k.cdc.MustUnmarshal(iter.Value(), &incident)
+		if cb(&incident) {
+			break
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// GetSendToEthereumStatus returns the lifecycle status of a send to ethereum by id
+func (k Keeper) GetSendToEthereumStatus(ctx sdk.Context, id uint64) *types.SendToEthereumStatus {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeSendToEthereumStatusKey(id))
+	if bz == nil {
+		return nil
+	}
+	var status types.SendToEthereumStatus
+	k.cdc.MustUnmarshal(bz, &status)
+	return &status
+}
+
+// setSendToEthereumStatus stores a status, and schedules its pruning once the send to ethereum
+// left the bridge
+func (k Keeper) setSendToEthereumStatus(ctx sdk.Context, status *types.SendToEthereumStatus) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MakeSendToEthereumStatusKey(status.Id), k.cdc.MustMarshal(status))
+	if isFinalSendToEthereumState(status.State) {
+		store.Set(types.MakeSendToEthereumStatusPruneKey(status.Height, status.Id), []byte{})
+	}
+}
+
+// updateSendToEthereumStatus applies an update to the status of a send to ethereum at the current height
+func (k Keeper) updateSendToEthereumStatus(ctx sdk.Context, id uint64, update func(*types.SendToEthereumStatus)) {
+	status := k.GetSendToEthereumStatus(ctx, id)
+	if status == nil {
+		status = &types.SendToEthereumStatus{Id: id}
+	}
+	update(status)
+	status.Height = uint64(ctx.BlockHeight())
+	k.setSendToEthereumStatus(ctx, status)
+}
+
+// setSendToEthereumState moves a send to ethereum to a new state, clearing the batch it was
+// part of when it returns to the pool
+func (k Keeper) setSendToEthereumState(ctx sdk.Context, id uint64, state types.SendToEthereumState) {
+	k.updateSendToEthereumStatus(ctx, id, func(status *types.SendToEthereumStatus) {
+		status.State = state
+		if state == types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_POOLED {
+			status.BatchNonce = 0
+		}
+	})
+}
+
+// IterateSendToEthereumStatuses iterates over the send to ethereum statuses by id
+func (k Keeper) IterateSendToEthereumStatuses(ctx sdk.Context, cb func(*types.SendToEthereumStatus) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.SendToEthereumStatusKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var status types.SendToEthereumStatus
+		k.cdc.MustUnmarshal(iter.Value(), &status)
+		if cb(&status) {
+			break
+		}
+	}
+}
+
+// PruneSendToEthereumStatuses deletes the statuses of the send to ethereums that left the bridge
+// at or before maxHeight
+func (k Keeper) PruneSendToEthereumStatuses(ctx sdk.Context, maxHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte{types.SendToEthereumStatusPruneKey}, types.MakeSendToEthereumStatusPruneKey(maxHeight+1, 0))
+	var pruned [][]byte
+	for ; iter.Valid(); iter.Next() {
+		pruned = append(pruned, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range pruned {
+		id := sdk.BigEndianToUint64(key[len(key)-8:])
+		store.Delete(types.MakeSendToEthereumStatusKey(id))
+		store.Delete(key)
+	}
+}
+
+func isFinalSendToEthereumState(state types.SendToEthereumState) bool {
+	switch state {
+	case types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_EXECUTED,
+		types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_CANCELED,
+		types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_REFUNDED:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestSendToEthereumStatus(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	require.NoError(t, input.AddBalanceToBank(ctx, mySender, allVouchers))
+
+	state := func(id uint64) types.SendToEthereumState {
+		status := gk.GetSendToEthereumStatus(ctx, id)
+		require.NotNil(t, status)
+		return status.State
+	}
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 4)
+	for _, id := range []uint64{1, 2, 3} {
+		require.Equal(t, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_POOLED, state(id))
+	}
+
+	// cancel
+	require.NoError(t, gk.cancelSendToEthereum(ctx, 1, mySender.String()))
+	require.Equal(t, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_CANCELED, state(1))
+
+	// batch
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 1)
+	require.NotNil(t, batch)
+	status := gk.GetSendToEthereumStatus(ctx, 3)
+	require.Equal(t, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_BATCHED, status.State)
+	require.Equal(t, batch.BatchNonce, status.BatchNonce)
+
+	// timeout, back to the pool
+	gk.CancelBatchTx(ctx, batch)
+	gk.IncrementSendToEthereumBatchTimeouts(ctx, 3)
+	status = gk.GetSendToEthereumStatus(ctx, 3)
+	require.Equal(t, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_POOLED, status.State)
+	require.Equal(t, uint64(0), status.BatchNonce)
+	require.Equal(t, uint64(1), status.BatchTimeouts)
+
+	// execution
+	batch = gk.BuildBatchTx(ctx, myTokenContractAddr, 1)
+	require.NotNil(t, batch)
+	require.NoError(t, gk.batchTxExecuted(ctx, myTokenContractAddr, batch.BatchNonce, 1000))
+	status = gk.GetSendToEthereumStatus(ctx, 3)
+	require.Equal(t, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_EXECUTED, status.State)
+	require.Equal(t, batch.BatchNonce, status.BatchNonce)
+	require.Equal(t, uint64(1000), status.EthereumHeight)
+	require.Equal(t, uint64(1), status.BatchTimeouts)
+
+	// refund
+	gk.RefundStaleSendToEthereum(ctx, gk.getUnbatchedSendToEthereum(ctx, 2), types.RefundReasonMaxPoolAge)
+	status = gk.GetSendToEthereumStatus(ctx, 2)
+	require.Equal(t, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_REFUNDED, status.State)
+	require.Equal(t, types.RefundReasonMaxPoolAge, status.RefundReason)
+
+	// finished transfers are pruned once past the retention
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 5)
+	gk.PruneSendToEthereumStatuses(ctx, uint64(ctx.BlockHeight())-1)
+	require.NotNil(t, gk.GetSendToEthereumStatus(ctx, 1))
+	gk.PruneSendToEthereumStatuses(ctx, uint64(ctx.BlockHeight()))
+	for _, id := range []uint64{1, 2, 3} {
+		require.Nil(t, gk.GetSendToEthereumStatus(ctx, id))
+	}
+	require.Equal(t, types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_POOLED, state(4))
+
+	res, err := gk.SendToEthereumStatus(sdk.WrapSDKContext(ctx), &types.SendToEthereumStatusRequest{Id: 4})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.Status.Id)
+	_, err = gk.SendToEthereumStatus(sdk.WrapSDKContext(ctx), &types.SendToEthereumStatusRequest{Id: 1})
+	require.Error(t, err)
+}
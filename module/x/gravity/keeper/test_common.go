@@ -202,6 +202,17 @@ var (
 		BatchCreationPeriod:                       10,
 		BatchMaxElement:                           100,
 		ObserveEthereumHeightPeriod:               50,
+		BatchSelectionStrategy:                    types.BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_FEE_GREEDY,
+		BatchOldestShare:                          sdk.NewDecWithPrec(2, 1),
+		SignerSetPowerDiffThreshold:               sdk.NewDecWithPrec(5, 2),
+		SlashFractionBadEthereumSignature:         sdk.NewDecWithPrec(1, 2),
+		ConflictingEthereumEventGraceVotes:        1,
+		ConflictingEthereumEventGraceWindow:       100,
+		EthereumEventVoteSlashingMode:             types.EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_DISABLED,
+		SlashFractionContractCallTx:               sdk.NewDecWithPrec(1, 2),
+		BridgeSigningWindow:                       1,
+		BridgeSigningMaxMissedRatio:               sdk.ZeroDec(),
+		OracleLagEventThreshold:                   10,
 	}
 )
 
@@ -297,7 +308,7 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 }
 
 // CreateTestEnv creates the keeper testing environment for gravity
-func CreateTestEnv(t *testing.T) TestInput {
+func CreateTestEnv(t testing.TB) TestInput {
 	t.Helper()
 
 	// Initialize store keys
@@ -0,0 +1,232 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) error {
+	ctx.Logger().Info("Gravity v2 to v3: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+
+	migrateParams(ctx, paramSpace)
+	indexUnbatchedSendToEthereumHeights(ctx, store)
+	indexUnbatchedSendToEthereums(store)
+	setSendToEthereumStatuses(ctx, store, cdc)
+	indexOutgoingTxs(store, cdc)
+	setSignerSetHashes(store, cdc)
+	setEthereumSignatureCheckpoints(ctx, store, cdc, paramSpace)
+	setUntrackedEthereumEventAcceptedHeight(ctx, store)
+
+	ctx.Logger().Info("Gravity v2 to v3: Store migration complete")
+
+	return nil
+}
+
+// setUntrackedEthereumEventAcceptedHeight takes the upgrade height as the accepted height of the
+// event nonces accepted before v3, so validators already behind on them are held to the oracle
+// liveness window from the upgrade on
+func setUntrackedEthereumEventAcceptedHeight(ctx sdk.Context, store storetypes.KVStore) {
+	store.Set([]byte{types.UntrackedEthereumEventAcceptedHeightKey}, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// migrateParams sets the params introduced in v3 to their defaults
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	defaults := types.DefaultParams()
+	paramSpace.Set(ctx, types.ParamStoreSendToEthereumMaxPoolAge, defaults.SendToEthereumMaxPoolAge)
+	paramSpace.Set(ctx, types.ParamStoreSendToEthereumMaxBatchTimeouts, defaults.SendToEthereumMaxBatchTimeouts)
+	paramSpace.Set(ctx, types.ParamStoreMinBridgeFees, defaults.MinBridgeFees)
+	paramSpace.Set(ctx, types.ParamStoreOutflowLimits, defaults.OutflowLimits)
+	paramSpace.Set(ctx, types.ParamStoreInflowLimits, defaults.InflowLimits)
+	paramSpace.Set(ctx, types.ParamStoreSendToEthereumStatusRetention, defaults.SendToEthereumStatusRetention)
+	paramSpace.Set(ctx, types.ParamStoreBatchSelectionStrategy, defaults.BatchSelectionStrategy)
+	paramSpace.Set(ctx, types.ParamStoreBatchOldestShare, defaults.BatchOldestShare)
+	paramSpace.Set(ctx, types.ParamStoreBatchTriggers, defaults.BatchTriggers)
+	paramSpace.Set(ctx, types.ParamStoreBatchSettingsOverrides, defaults.BatchSettingsOverrides)
+	paramSpace.Set(ctx, types.ParamStoreExecutedBatchStatsWindow, defaults.ExecutedBatchStatsWindow)
+	paramSpace.Set(ctx, types.ParamStoreSignerSetPowerDiffThreshold, defaults.SignerSetPowerDiffThreshold)
+	paramSpace.Set(ctx, types.ParamStoreSignerSetMaxAge, defaults.SignerSetMaxAge)
+	paramSpace.Set(ctx, types.ParamStoreSlashFractionBadEthereumSignature, defaults.SlashFractionBadEthereumSignature)
+	paramSpace.Set(ctx, types.ParamStoreConflictingEthereumEventSlashing, defaults.ConflictingEthereumEventSlashing)
+	paramSpace.Set(ctx, types.ParamStoreConflictingEthereumEventGraceVotes, defaults.ConflictingEthereumEventGraceVotes)
+	paramSpace.Set(ctx, types.ParamStoreConflictingEthereumEventGraceWindow, defaults.ConflictingEthereumEventGraceWindow)
+	paramSpace.Set(ctx, types.ParamStoreEthereumEventVoteSlashingMode, defaults.EthereumEventVoteSlashingMode)
+	paramSpace.Set(ctx, types.ParamStoreSlashFractionContractCallTx, defaults.SlashFractionContractCallTx)
+	paramSpace.Set(ctx, types.ParamStoreBridgeSigningWindow, defaults.BridgeSigningWindow)
+	paramSpace.Set(ctx, types.ParamStoreBridgeSigningMaxMissedRatio, defaults.BridgeSigningMaxMissedRatio)
+	paramSpace.Set(ctx, types.ParamStoreOracleLagEventThreshold, defaults.OracleLagEventThreshold)
+}
+
+// indexUnbatchedSendToEthereumHeights records the current height as the pool height of every
+// unbatched send to ethereum, since the height they entered the pool at was not tracked before v3
+func indexUnbatchedSendToEthereumHeights(ctx sdk.Context, store storetypes.KVStore) {
+	height := uint64(ctx.BlockHeight())
+
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.SendToEthereumKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		id := sdk.BigEndianToUint64(key[len(key)-8:])
+
+		store.Set(types.MakeSendToEthereumHeightKey(id), sdk.Uint64ToBigEndian(height))
+		store.Set(types.MakeSendToEthereumPoolHeightKey(height, id), key)
+	}
+}
+
+// indexUnbatchedSendToEthereums indexes every unbatched send to ethereum by id, sender, recipient and token contract
+func indexUnbatchedSendToEthereums(store storetypes.KVStore) {
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.SendToEthereumKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ste types.SendToEthereum
+		if err := ste.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+
+		key := iter.Key()
+		store.Set(types.MakeSendToEthereumByIDKey(ste.Id), key)
+		store.Set(types.MakeSendToEthereumBySenderKey(sdk.MustAccAddressFromBech32(ste.Sender), ste.Id), key)
+		store.Set(types.MakeSendToEthereumByRecipientKey(common.HexToAddress(ste.EthereumRecipient), ste.Id), key)
+		store.Set(types.MakeSendToEthereumByContractKey(common.HexToAddress(ste.Erc20Token.Contract), ste.Id), key)
+	}
+}
+
+// setSendToEthereumStatuses records the status of the send to ethereums in the pool or in a
+// batch, since their lifecycle was not tracked before v3
+func setSendToEthereumStatuses(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) {
+	height := uint64(ctx.BlockHeight())
+	setStatus := func(status types.SendToEthereumStatus) {
+		status.Height = height
+		store.Set(types.MakeSendToEthereumStatusKey(status.Id), cdc.MustMarshal(&status))
+	}
+
+	poolIter := sdk.KVStorePrefixIterator(store, []byte{types.SendToEthereumKey})
+	defer poolIter.Close()
+	for ; poolIter.Valid(); poolIter.Next() {
+		var ste types.SendToEthereum
+		cdc.MustUnmarshal(poolIter.Value(), &ste)
+		setStatus(types.SendToEthereumStatus{
+			Id:    ste.Id,
+			State: types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_POOLED,
+		})
+	}
+
+	batchIter := sdk.KVStorePrefixIterator(store, types.MakeOutgoingTxKey([]byte{types.BatchTxPrefixByte}))
+	defer batchIter.Close()
+	for ; batchIter.Valid(); batchIter.Next() {
+		var any codectypes.Any
+		cdc.MustUnmarshal(batchIter.Value(), &any)
+		var otx types.OutgoingTx
+		if err := cdc.UnpackAny(&any, &otx); err != nil {
+			panic(err)
+		}
+		batch, _ := otx.(*types.BatchTx)
+		for _, ste := range batch.Transactions {
+			setStatus(types.SendToEthereumStatus{
+				Id:         ste.Id,
+				State:      types.SendToEthereumState_SEND_TO_ETHEREUM_STATE_BATCHED,
+				BatchNonce: batch.BatchNonce,
+			})
+		}
+	}
+}
+
+// indexOutgoingTxs indexes every outgoing tx by creation height, every outgoing batch tx by token
+// contract and nonce, every outgoing contract call tx by invalidation scope and nonce, and both by
+// their timeout height
+func indexOutgoingTxs(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.OutgoingTxKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var any codectypes.Any
+		cdc.MustUnmarshal(iter.Value(), &any)
+		var otx types.OutgoingTx
+		if err := cdc.UnpackAny(&any, &otx); err != nil {
+			panic(err)
+		}
+
+		storeIndex := otx.GetStoreIndex()
+		store.Set(types.MakeOutgoingTxByHeightKey(otx.GetCosmosHeight(), storeIndex), storeIndex)
+		switch otx := otx.(type) {
+		case *types.BatchTx:
+			store.Set(types.MakeOutgoingBatchTxByTokenKey(common.HexToAddress(otx.TokenContract), otx.BatchNonce), storeIndex)
+			store.Set(types.MakeOutgoingTxTimeoutKey(types.BatchTxPrefixByte, otx.Timeout, storeIndex), storeIndex)
+		case *types.ContractCallTx:
+			store.Set(types.MakeOutgoingContractCallTxByScopeKey(otx.InvalidationScope, otx.InvalidationNonce), storeIndex)
+			store.Set(types.MakeOutgoingTxTimeoutKey(types.ContractCallTxPrefixByte, otx.Timeout, storeIndex), storeIndex)
+		}
+	}
+}
+
+// setSignerSetHashes keeps the hash of every signer set tx still in the store, so that they are
+// checked when observed. The signer set txs already pruned are accepted without a check.
+func setSignerSetHashes(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.OutgoingTxKey, types.SignerSetTxPrefixByte})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var any codectypes.Any
+		cdc.MustUnmarshal(iter.Value(), &any)
+		var otx types.OutgoingTx
+		if err := cdc.UnpackAny(&any, &otx); err != nil {
+			panic(err)
+		}
+
+		signerSet := otx.(*types.SignerSetTx)
+		store.Set(types.MakeSignerSetHashKey(signerSet.Nonce), signerSet.Signers.Hash())
+	}
+}
+
+// setEthereumSignatureCheckpoints keeps the checkpoints of the outgoing txs still in the store, and
+// records the latest nonces issued so far, and the latest invalidation nonce of each scope with
+// contract calls, as the floor below which bad signature evidence is not accepted, since the
+// checkpoints of outgoing txs pruned before v3 are gone
+func setEthereumSignatureCheckpoints(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) {
+	var gravityID string
+	paramSpace.Get(ctx, types.ParamsStoreKeyGravityID, &gravityID)
+
+	floor := types.BadSignatureEvidenceFloor{}
+	scopes := map[string]*types.ContractCallScopeFloor{}
+
+	iter := sdk.KVStorePrefixIterator(store, []byte{types.OutgoingTxKey})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var any codectypes.Any
+		cdc.MustUnmarshal(iter.Value(), &any)
+		var otx types.OutgoingTx
+		if err := cdc.UnpackAny(&any, &otx); err != nil {
+			panic(err)
+		}
+
+		store.Set(types.MakeEthereumSignatureCheckpointKey(otx.GetCheckpoint([]byte(gravityID))), []byte{0x1})
+
+		cctx, ok := otx.(*types.ContractCallTx)
+		if !ok {
+			continue
+		}
+		// the store is iterated in key order, so the scopes are added in the same order on every node
+		scope, found := scopes[string(cctx.InvalidationScope)]
+		if !found {
+			scope = &types.ContractCallScopeFloor{InvalidationScope: cctx.InvalidationScope}
+			scopes[string(cctx.InvalidationScope)] = scope
+			floor.ContractCallScopes = append(floor.ContractCallScopes, scope)
+		}
+		if cctx.InvalidationNonce > scope.InvalidationNonce {
+			scope.InvalidationNonce = cctx.InvalidationNonce
+		}
+	}
+
+	if bz := store.Get([]byte{types.LatestSignerSetTxNonceKey}); bz != nil {
+		floor.SignerSetNonce = sdk.BigEndianToUint64(bz)
+	}
+	if bz := store.Get([]byte{types.LastOutgoingBatchNonceKey}); bz != nil {
+		floor.BatchNonce = sdk.BigEndianToUint64(bz)
+	}
+	store.Set([]byte{types.BadSignatureEvidenceFloorKey}, cdc.MustMarshal(&floor))
+}
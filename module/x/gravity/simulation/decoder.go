@@ -55,7 +55,8 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			bytes.Equal(kvA.Key[:1], []byte{types.LastSlashedOutgoingTxBlockKey}),
 			bytes.Equal(kvA.Key[:1], []byte{types.LastOutgoingBatchNonceKey}),
 			bytes.Equal(kvA.Key[:1], []byte{types.LastSendToEthereumIDKey}),
-			bytes.Equal(kvA.Key[:1], []byte{types.LastUnBondingBlockHeightKey}):
+			bytes.Equal(kvA.Key[:1], []byte{types.LastUnBondingBlockHeightKey}),
+			bytes.Equal(kvA.Key[:1], []byte{types.LastSignerSetChangeBlockHeightKey}):
 			return fmt.Sprintf("%v\n%v", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
 
 		case bytes.Equal(kvA.Key[:1], []byte{types.DenomToERC20Key}):
@@ -78,6 +79,53 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &heightB)
 
 			return fmt.Sprintf("%v\n%v", heightA, heightB)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.SignerSetHashKey}):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.SignerSetHijackIncidentKey}):
+			var incidentA, incidentB types.SignerSetHijackIncident
+			cdc.MustUnmarshal(kvA.Value, &incidentA)
+			cdc.MustUnmarshal(kvB.Value, &incidentB)
+			return fmt.Sprintf("%v\n%v", incidentA, incidentB)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.EthereumSignatureCheckpointKey}):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.BadSignatureEvidenceKey}):
+			var evidenceA, evidenceB types.BadSignatureEvidence
+			cdc.MustUnmarshal(kvA.Value, &evidenceA)
+			cdc.MustUnmarshal(kvB.Value, &evidenceB)
+			return fmt.Sprintf("%v\n%v", evidenceA, evidenceB)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.BadSignatureEvidenceFloorKey}):
+			var floorA, floorB types.BadSignatureEvidenceFloor
+			cdc.MustUnmarshal(kvA.Value, &floorA)
+			cdc.MustUnmarshal(kvB.Value, &floorB)
+			return fmt.Sprintf("%v\n%v", floorA, floorB)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.ConflictingEventVoteKey}):
+			var voteA, voteB types.ConflictingEventVote
+			cdc.MustUnmarshal(kvA.Value, &voteA)
+			cdc.MustUnmarshal(kvB.Value, &voteB)
+			return fmt.Sprintf("%v\n%v", voteA, voteB)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.EthereumEventAcceptedHeightKey}),
+			bytes.Equal(kvA.Key[:1], []byte{types.EthereumEventExcusedNonceKey}),
+			bytes.Equal(kvA.Key[:1], []byte{types.LastBridgeInactiveHeightKey}),
+			bytes.Equal(kvA.Key[:1], []byte{types.ReturnedQuarantinedDepositKey}),
+			bytes.Equal(kvA.Key[:1], []byte{types.UntrackedEthereumEventAcceptedHeightKey}):
+			return fmt.Sprintf("%v\n%v", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.BridgeSigningInfoKey}):
+			var infoA, infoB types.BridgeSigningInfo
+			cdc.MustUnmarshal(kvA.Value, &infoA)
+			cdc.MustUnmarshal(kvB.Value, &infoB)
+			return fmt.Sprintf("%v\n%v", infoA, infoB)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.LaggingOracleValidatorKey}):
+			return fmt.Sprintf("%v\n%v", sdk.ValAddress(kvA.Key[1:]), sdk.ValAddress(kvB.Key[1:]))
+
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -51,6 +51,19 @@ func genRandomParams(r *rand.Rand) types.Params {
 		BatchCreationPeriod:                       uint64(r.Intn(maxBlocksInOneRound-1) + 1),
 		BatchMaxElement:                           uint64(r.Intn(100)),
 		ObserveEthereumHeightPeriod:               r.Uint64(),
+		BatchSelectionStrategy:                    types.BatchSelectionStrategy(r.Intn(3) + 1),
+		BatchOldestShare:                          sdk.NewDecWithPrec(int64(r.Intn(11)), 1),
+		SignerSetPowerDiffThreshold:               sdk.NewDecWithPrec(int64(r.Intn(11)), 2),
+		SignerSetMaxAge:                           uint64(r.Intn(maxBlocksInOneRound)),
+		SlashFractionBadEthereumSignature:         sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		ConflictingEthereumEventSlashing:          r.Intn(2) == 0,
+		ConflictingEthereumEventGraceVotes:        uint64(r.Intn(10)),
+		ConflictingEthereumEventGraceWindow:       uint64(r.Intn(maxBlocksInOneRound)),
+		EthereumEventVoteSlashingMode:             types.EthereumEventVoteSlashingMode(r.Intn(3) + 1),
+		SlashFractionContractCallTx:               sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		BridgeSigningWindow:                       uint64(r.Intn(100) + 1),
+		BridgeSigningMaxMissedRatio:               sdk.NewDecWithPrec(int64(r.Intn(100)), 2),
+		OracleLagEventThreshold:                   uint64(r.Intn(20)),
 	}
 }
 
@@ -18,6 +18,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegateKeys{}, "gravity-bridge/MsgDelegateKeys", nil)
 	cdc.RegisterConcrete(&MsgSendToEthereum{}, "gravity-bridge/MsgSendToEthereum", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEthereum{}, "gravity-bridge/MsgCancelSendToEthereum", nil)
+	cdc.RegisterConcrete(&MsgIncreaseBridgeFee{}, "gravity-bridge/MsgIncreaseBridgeFee", nil)
 }
 
 var (
@@ -48,6 +49,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSubmitEthereumTxConfirmation{},
 		&MsgDelegateKeys{},
 		&MsgEthereumHeightVote{},
+		&MsgIncreaseBridgeFee{},
+		&MsgSubmitBadSignatureEvidence{},
 	)
 
 	registry.RegisterInterface(
@@ -78,6 +81,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&CommunityPoolEthereumSpendProposal{},
+		&ReleaseQuarantinedDepositsProposal{},
+		&ReturnQuarantinedDepositsProposal{},
+		&AddEthereumDenylistProposal{},
+		&RemoveEthereumDenylistProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -16,4 +16,9 @@ var (
 	ErrEthereumProposalDenomMismatch    = sdkerrors.Register(ModuleName, 11, "community pool Ethereum spend proposal amount and bridge fee denom mismatch")
 	ErrBatchExecutedError               = sdkerrors.Register(ModuleName, 12, "failed to clean batches")
 	ErrNotAuthorized                    = sdkerrors.Register(ModuleName, 13, "not authorized")
+	ErrBridgeFeeTooLow                  = sdkerrors.Register(ModuleName, 14, "bridge fee below the minimum")
+	ErrOutflowLimit                     = sdkerrors.Register(ModuleName, 15, "token outflow limit reached")
+	ErrQuarantinedDeposit               = sdkerrors.Register(ModuleName, 16, "invalid quarantined deposit")
+	ErrDeniedEthereumRecipient          = sdkerrors.Register(ModuleName, 17, "ethereum recipient denied")
+	ErrBadSignatureEvidence             = sdkerrors.Register(ModuleName, 18, "invalid bad signature evidence")
 )
@@ -26,6 +26,32 @@ func (m *EthereumEventVoteRecord) UnpackInterfaces(unpacker types.AnyUnpacker) e
 	return unpacker.UnpackAny(m.Event, &event)
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *EthereumEventVoteRecordDetails) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if m.VoteRecord == nil {
+		return nil
+	}
+	return m.VoteRecord.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *EthereumEventVoteRecordsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, record := range m.VoteRecords {
+		if err := record.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *EthereumEventVoteRecordResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if m.VoteRecord == nil {
+		return nil
+	}
+	return m.VoteRecord.UnpackInterfaces(unpacker)
+}
+
 //////////
 // Hash //
 //////////
@@ -9,6 +9,16 @@ const (
 	EventTypeBridgeWithdrawalReceived = "withdrawal_received"
 	EventTypeBridgeDepositReceived    = "deposit_received"
 	EventTypeBridgeWithdrawCanceled   = "withdraw_canceled"
+	EventTypeBridgeFeeIncreased       = "bridge_fee_increased"
+	EventTypeBridgeWithdrawRefunded   = "withdraw_refunded"
+	EventTypeOutflowLimitReached      = "outflow_limit_reached"
+	EventTypeDepositQuarantined       = "deposit_quarantined"
+	EventTypeQuarantineReleased       = "quarantine_released"
+	EventTypeQuarantineReturned       = "quarantine_returned"
+	EventTypeEthereumDenylistUpdated  = "ethereum_denylist_updated"
+	EventTypeBatchTriggered           = "batch_triggered"
+	EventTypeSignerSetHijacked        = "signer_set_hijacked"
+	EventTypeOracleLagging            = "oracle_lagging"
 
 	AttributeKeyEthereumEventVoteRecordID     = "ethereum_event_vote_record_id"
 	AttributeKeyBatchConfirmKey               = "batch_confirm_key"
@@ -31,5 +41,36 @@ const (
 	AttributeKeyContractCallFees              = "contract_call_fees"
 	AttributeKeyContractCallAddress           = "contract_call_address"
 	AttributeKeyEthTxTimeout                  = "eth_tx_timeout"
+	AttributeKeyBridgeFee                     = "bridge_fee"
+	AttributeKeySender                        = "sender"
+	AttributeKeyRefundReason                  = "refund_reason"
+	AttributeKeyTokenContract                 = "token_contract"
+	AttributeKeyOutflow                       = "outflow"
+	AttributeKeyOutflowLimit                  = "outflow_limit"
+	AttributeKeyPausedUntil                   = "paused_until"
+	AttributeKeyQuarantinedDepositID          = "quarantined_deposit_id"
+	AttributeKeyInflow                        = "inflow"
+	AttributeKeyInflowLimit                   = "inflow_limit"
+	AttributeKeyEthereumAddress               = "ethereum_address"
+	AttributeKeyDenied                        = "denied"
+	AttributeKeyBatchTriggerReason            = "trigger_reason"
+	AttributeKeyExpectedHash                  = "expected_hash"
+	AttributeKeyObservedHash                  = "observed_hash"
+	AttributeKeyLastObservedNonce             = "last_observed_nonce"
+	AttributeKeyNoncesBehind                  = "nonces_behind"
+	AttributeMissingBridgeSignerSetSig        = "missing_bridge_signer_set_signature"
 	AttributeMissingBridgeBatchSig            = "missing_bridge_batch_signature"
+	AttributeMissingBridgeContractCallSig     = "missing_bridge_contract_call_signature"
+	AttributeBadEthereumSignature             = "bad_ethereum_signature"
+	AttributeConflictingEthereumEventVote     = "conflicting_ethereum_event_vote"
+	AttributeMissingEthereumEventVote         = "missing_ethereum_event_vote"
+
+	RefundReasonMaxPoolAge       = "max_pool_age"
+	RefundReasonMaxBatchTimeouts = "max_batch_timeouts"
+	RefundReasonDeniedRecipient  = "denied_recipient"
+
+	BatchTriggerReasonMinFees             = "min_fees"
+	BatchTriggerReasonMinPoolSize         = "min_pool_size"
+	BatchTriggerReasonMaxPoolAge          = "max_pool_age"
+	BatchTriggerReasonBatchCreationPeriod = "batch_creation_period"
 )
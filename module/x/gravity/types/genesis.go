@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"time"
 
@@ -77,6 +78,72 @@ var (
 	// ParamStoreObserveEthereumHeightPeriod store the observe ethereum height period
 	ParamStoreObserveEthereumHeightPeriod = []byte("ObserveEthereumHeightPeriod")
 
+	// ParamStoreSendToEthereumMaxPoolAge stores the number of blocks a send to ethereum may stay unbatched
+	ParamStoreSendToEthereumMaxPoolAge = []byte("SendToEthereumMaxPoolAge")
+
+	// ParamStoreSendToEthereumMaxBatchTimeouts stores the number of batch timeouts a send to ethereum may go through
+	ParamStoreSendToEthereumMaxBatchTimeouts = []byte("SendToEthereumMaxBatchTimeouts")
+
+	// ParamStoreMinBridgeFees stores the minimum bridge fee of each token
+	ParamStoreMinBridgeFees = []byte("MinBridgeFees")
+
+	// ParamStoreOutflowLimits stores the rolling window outflow limit of each token
+	ParamStoreOutflowLimits = []byte("OutflowLimits")
+
+	// ParamStoreInflowLimits stores the rolling window inflow limit of each token
+	ParamStoreInflowLimits = []byte("InflowLimits")
+
+	// ParamStoreSendToEthereumStatusRetention stores the number of blocks the status of a finished send to ethereum is kept
+	ParamStoreSendToEthereumStatusRetention = []byte("SendToEthereumStatusRetention")
+
+	// ParamStoreBatchSelectionStrategy stores how the send to ethereums of a batch are picked from the pool
+	ParamStoreBatchSelectionStrategy = []byte("BatchSelectionStrategy")
+
+	// ParamStoreBatchOldestShare stores the share of batch slots the hybrid strategy reserves for the oldest send to ethereums
+	ParamStoreBatchOldestShare = []byte("BatchOldestShare")
+
+	// ParamStoreBatchTriggers stores the conditions for creating the batches of each token
+	ParamStoreBatchTriggers = []byte("BatchTriggers")
+
+	// ParamStoreBatchSettingsOverrides stores the batch settings overridden for each token
+	ParamStoreBatchSettingsOverrides = []byte("BatchSettingsOverrides")
+
+	// ParamStoreExecutedBatchStatsWindow stores the number of executed batches per token whose stats are kept
+	ParamStoreExecutedBatchStatsWindow = []byte("ExecutedBatchStatsWindow")
+
+	// ParamStoreSignerSetPowerDiffThreshold stores the share of the bridge power that must move before a new signer set is created
+	ParamStoreSignerSetPowerDiffThreshold = []byte("SignerSetPowerDiffThreshold")
+
+	// ParamStoreSignerSetMaxAge stores the number of blocks after which a new signer set is created regardless of power changes
+	ParamStoreSignerSetMaxAge = []byte("SignerSetMaxAge")
+
+	// ParamStoreSlashFractionBadEthereumSignature stores the slash fraction for signing over a checkpoint the module never created
+	ParamStoreSlashFractionBadEthereumSignature = []byte("SlashFractionBadEthereumSignature")
+
+	// ParamStoreConflictingEthereumEventSlashing stores whether validators are slashed for voting for a conflicting event
+	ParamStoreConflictingEthereumEventSlashing = []byte("ConflictingEthereumEventSlashing")
+
+	// ParamStoreConflictingEthereumEventGraceVotes stores the number of conflicting votes a validator may cast within the grace window
+	ParamStoreConflictingEthereumEventGraceVotes = []byte("ConflictingEthereumEventGraceVotes")
+
+	// ParamStoreConflictingEthereumEventGraceWindow stores the number of blocks within which conflicting votes are counted
+	ParamStoreConflictingEthereumEventGraceWindow = []byte("ConflictingEthereumEventGraceWindow")
+
+	// ParamStoreEthereumEventVoteSlashingMode stores how validators are penalised for not voting on accepted events
+	ParamStoreEthereumEventVoteSlashingMode = []byte("EthereumEventVoteSlashingMode")
+
+	// ParamStoreSlashFractionContractCallTx stores the slash fraction for missing contract call signatures
+	ParamStoreSlashFractionContractCallTx = []byte("SlashFractionContractCallTx")
+
+	// ParamStoreBridgeSigningWindow stores the number of outgoing txs counted for the bridge signing info
+	ParamStoreBridgeSigningWindow = []byte("BridgeSigningWindow")
+
+	// ParamStoreBridgeSigningMaxMissedRatio stores the share of the bridge signing window a validator may miss
+	ParamStoreBridgeSigningMaxMissedRatio = []byte("BridgeSigningMaxMissedRatio")
+
+	// ParamStoreOracleLagEventThreshold stores the number of event nonces a validator may fall behind before an event is emitted
+	ParamStoreOracleLagEventThreshold = []byte("OracleLagEventThreshold")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -119,6 +186,80 @@ func (s GenesisState) ValidateBasic() error {
 			}
 		}
 	}
+	if err := ValidateEthereumDenylist(s.EthereumDenylist); err != nil {
+		return sdkerrors.Wrap(err, "ethereum denylist")
+	}
+	for _, stats := range s.ExecutedBatchStats {
+		if !common.IsHexAddress(stats.TokenContract) {
+			return sdkerrors.Wrapf(ErrInvalid, "executed batch stats token contract %s", stats.TokenContract)
+		}
+	}
+	for _, signerSetHash := range s.SignerSetHashes {
+		if len(signerSetHash.Hash) != sha256.Size {
+			return sdkerrors.Wrapf(ErrInvalid, "signer set %d hash length %d", signerSetHash.Nonce, len(signerSetHash.Hash))
+		}
+	}
+	for _, checkpoint := range s.EthereumSignatureCheckpoints {
+		if len(checkpoint) != common.HashLength {
+			return sdkerrors.Wrapf(ErrInvalid, "ethereum signature checkpoint length %d", len(checkpoint))
+		}
+	}
+	for _, evidence := range s.BadSignatureEvidence {
+		if len(evidence.Checkpoint) != common.HashLength {
+			return sdkerrors.Wrapf(ErrInvalid, "bad signature evidence checkpoint length %d", len(evidence.Checkpoint))
+		}
+		if !common.IsHexAddress(evidence.EthereumSigner) {
+			return sdkerrors.Wrapf(ErrInvalid, "bad signature evidence ethereum signer %s", evidence.EthereumSigner)
+		}
+	}
+	for _, vote := range s.ConflictingEventVotes {
+		if _, err := sdk.ValAddressFromBech32(vote.ValidatorAddress); err != nil {
+			return sdkerrors.Wrap(err, "conflicting event vote validator address")
+		}
+	}
+	for _, excused := range s.EthereumEventExcusedNonces {
+		if _, err := sdk.ValAddressFromBech32(excused.ValidatorAddress); err != nil {
+			return sdkerrors.Wrap(err, "ethereum event excused nonce validator address")
+		}
+	}
+	for _, info := range s.BridgeSigningInfos {
+		if _, err := sdk.ValAddressFromBech32(info.ValidatorAddress); err != nil {
+			return sdkerrors.Wrap(err, "bridge signing info validator address")
+		}
+	}
+	for _, record := range s.SendToEthereumPoolRecords {
+		if record.Id == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "send to ethereum pool record id")
+		}
+	}
+	if err := validateWindowAmounts(s.Outflows); err != nil {
+		return sdkerrors.Wrap(err, "outflows")
+	}
+	if err := validateWindowAmounts(s.Inflows); err != nil {
+		return sdkerrors.Wrap(err, "inflows")
+	}
+	for _, pause := range s.OutflowPauses {
+		if !common.IsHexAddress(pause.TokenContract) {
+			return sdkerrors.Wrapf(ErrInvalid, "outflow pause token contract %s", pause.TokenContract)
+		}
+	}
+	for _, val := range s.LaggingOracleValidators {
+		if _, err := sdk.ValAddressFromBech32(val); err != nil {
+			return sdkerrors.Wrap(err, "lagging oracle validator address")
+		}
+	}
+	return nil
+}
+
+func validateWindowAmounts(amounts []*WindowAmount) error {
+	for _, amount := range amounts {
+		if !common.IsHexAddress(amount.TokenContract) {
+			return sdkerrors.Wrapf(ErrInvalid, "token contract %s", amount.TokenContract)
+		}
+		if amount.Amount.IsNil() || !amount.Amount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalid, "amount of %s at height %d", amount.TokenContract, amount.Height)
+		}
+	}
 	return nil
 }
 
@@ -150,6 +291,28 @@ func DefaultParams() *Params {
 		BatchCreationPeriod:                       10,
 		BatchMaxElement:                           100,
 		ObserveEthereumHeightPeriod:               50,
+		SendToEthereumMaxPoolAge:                  0,
+		SendToEthereumMaxBatchTimeouts:            0,
+		MinBridgeFees:                             []ERC20Token{},
+		OutflowLimits:                             []OutflowLimit{},
+		InflowLimits:                              []InflowLimit{},
+		SendToEthereumStatusRetention:             100000,
+		BatchSelectionStrategy:                    BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_FEE_GREEDY,
+		BatchOldestShare:                          sdk.NewDecWithPrec(2, 1),
+		BatchTriggers:                             []BatchTrigger{},
+		BatchSettingsOverrides:                    []BatchSettings{},
+		ExecutedBatchStatsWindow:                  100,
+		SignerSetPowerDiffThreshold:               sdk.NewDecWithPrec(5, 2),
+		SignerSetMaxAge:                           0,
+		SlashFractionBadEthereumSignature:         sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		ConflictingEthereumEventSlashing:          false,
+		ConflictingEthereumEventGraceVotes:        3,
+		ConflictingEthereumEventGraceWindow:       10000,
+		EthereumEventVoteSlashingMode:             EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_DISABLED,
+		SlashFractionContractCallTx:               sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		BridgeSigningWindow:                       100,
+		BridgeSigningMaxMissedRatio:               sdk.NewDecWithPrec(5, 1),
+		OracleLagEventThreshold:                   10,
 	}
 }
 
@@ -200,6 +363,45 @@ func (p Params) ValidateBasic() error {
 	if err := validateUnbondSlashingSignerSetTxsWindow(p.UnbondSlashingSignerSetTxsWindow); err != nil {
 		return sdkerrors.Wrap(err, "unbond slashing signersettx window")
 	}
+	if err := validateMinBridgeFees(p.MinBridgeFees); err != nil {
+		return sdkerrors.Wrap(err, "min bridge fees")
+	}
+	if err := validateOutflowLimits(p.OutflowLimits); err != nil {
+		return sdkerrors.Wrap(err, "outflow limits")
+	}
+	if err := validateInflowLimits(p.InflowLimits); err != nil {
+		return sdkerrors.Wrap(err, "inflow limits")
+	}
+	if err := validateBatchSelectionStrategy(p.BatchSelectionStrategy); err != nil {
+		return sdkerrors.Wrap(err, "batch selection strategy")
+	}
+	if err := validateBatchOldestShare(p.BatchOldestShare); err != nil {
+		return sdkerrors.Wrap(err, "batch oldest share")
+	}
+	if err := validateBatchTriggers(p.BatchTriggers); err != nil {
+		return sdkerrors.Wrap(err, "batch triggers")
+	}
+	if err := validateBatchSettingsOverrides(p.BatchSettingsOverrides); err != nil {
+		return sdkerrors.Wrap(err, "batch settings overrides")
+	}
+	if err := validateSignerSetPowerDiffThreshold(p.SignerSetPowerDiffThreshold); err != nil {
+		return sdkerrors.Wrap(err, "signer set power diff threshold")
+	}
+	if err := validateSlashFractionBadEthereumSignature(p.SlashFractionBadEthereumSignature); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction bad ethereum signature")
+	}
+	if err := validateEthereumEventVoteSlashingMode(p.EthereumEventVoteSlashingMode); err != nil {
+		return sdkerrors.Wrap(err, "ethereum event vote slashing mode")
+	}
+	if err := validateSlashFractionContractCallTx(p.SlashFractionContractCallTx); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction contract call tx")
+	}
+	if err := validateBridgeSigningWindow(p.BridgeSigningWindow); err != nil {
+		return sdkerrors.Wrap(err, "bridge signing window")
+	}
+	if err := validateBridgeSigningMaxMissedRatio(p.BridgeSigningMaxMissedRatio); err != nil {
+		return sdkerrors.Wrap(err, "bridge signing max missed ratio")
+	}
 
 	return nil
 }
@@ -232,6 +434,28 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreBatchCreationPeriod, &p.BatchCreationPeriod, validateBatchCreationPeriod),
 		paramtypes.NewParamSetPair(ParamStoreBatchMaxElement, &p.BatchMaxElement, validateBatchMaxElement),
 		paramtypes.NewParamSetPair(ParamStoreObserveEthereumHeightPeriod, &p.ObserveEthereumHeightPeriod, validateObserveEthereumHeightPeriod),
+		paramtypes.NewParamSetPair(ParamStoreSendToEthereumMaxPoolAge, &p.SendToEthereumMaxPoolAge, validateSendToEthereumMaxPoolAge),
+		paramtypes.NewParamSetPair(ParamStoreSendToEthereumMaxBatchTimeouts, &p.SendToEthereumMaxBatchTimeouts, validateSendToEthereumMaxBatchTimeouts),
+		paramtypes.NewParamSetPair(ParamStoreMinBridgeFees, &p.MinBridgeFees, validateMinBridgeFees),
+		paramtypes.NewParamSetPair(ParamStoreOutflowLimits, &p.OutflowLimits, validateOutflowLimits),
+		paramtypes.NewParamSetPair(ParamStoreInflowLimits, &p.InflowLimits, validateInflowLimits),
+		paramtypes.NewParamSetPair(ParamStoreSendToEthereumStatusRetention, &p.SendToEthereumStatusRetention, validateSendToEthereumStatusRetention),
+		paramtypes.NewParamSetPair(ParamStoreBatchSelectionStrategy, &p.BatchSelectionStrategy, validateBatchSelectionStrategy),
+		paramtypes.NewParamSetPair(ParamStoreBatchOldestShare, &p.BatchOldestShare, validateBatchOldestShare),
+		paramtypes.NewParamSetPair(ParamStoreBatchTriggers, &p.BatchTriggers, validateBatchTriggers),
+		paramtypes.NewParamSetPair(ParamStoreBatchSettingsOverrides, &p.BatchSettingsOverrides, validateBatchSettingsOverrides),
+		paramtypes.NewParamSetPair(ParamStoreExecutedBatchStatsWindow, &p.ExecutedBatchStatsWindow, validateExecutedBatchStatsWindow),
+		paramtypes.NewParamSetPair(ParamStoreSignerSetPowerDiffThreshold, &p.SignerSetPowerDiffThreshold, validateSignerSetPowerDiffThreshold),
+		paramtypes.NewParamSetPair(ParamStoreSignerSetMaxAge, &p.SignerSetMaxAge, validateSignerSetMaxAge),
+		paramtypes.NewParamSetPair(ParamStoreSlashFractionBadEthereumSignature, &p.SlashFractionBadEthereumSignature, validateSlashFractionBadEthereumSignature),
+		paramtypes.NewParamSetPair(ParamStoreConflictingEthereumEventSlashing, &p.ConflictingEthereumEventSlashing, validateConflictingEthereumEventSlashing),
+		paramtypes.NewParamSetPair(ParamStoreConflictingEthereumEventGraceVotes, &p.ConflictingEthereumEventGraceVotes, validateConflictingEthereumEventGraceVotes),
+		paramtypes.NewParamSetPair(ParamStoreConflictingEthereumEventGraceWindow, &p.ConflictingEthereumEventGraceWindow, validateConflictingEthereumEventGraceWindow),
+		paramtypes.NewParamSetPair(ParamStoreEthereumEventVoteSlashingMode, &p.EthereumEventVoteSlashingMode, validateEthereumEventVoteSlashingMode),
+		paramtypes.NewParamSetPair(ParamStoreSlashFractionContractCallTx, &p.SlashFractionContractCallTx, validateSlashFractionContractCallTx),
+		paramtypes.NewParamSetPair(ParamStoreBridgeSigningWindow, &p.BridgeSigningWindow, validateBridgeSigningWindow),
+		paramtypes.NewParamSetPair(ParamStoreBridgeSigningMaxMissedRatio, &p.BridgeSigningMaxMissedRatio, validateBridgeSigningMaxMissedRatio),
+		paramtypes.NewParamSetPair(ParamStoreOracleLagEventThreshold, &p.OracleLagEventThreshold, validateOracleLagEventThreshold),
 	}
 }
 
@@ -428,3 +652,280 @@ func validateObserveEthereumHeightPeriod(i interface{}) error {
 	}
 	return nil
 }
+
+func validateSendToEthereumMaxPoolAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSendToEthereumMaxBatchTimeouts(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSendToEthereumStatusRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBatchSelectionStrategy(i interface{}) error {
+	v, ok := i.(BatchSelectionStrategy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	switch v {
+	case BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_FEE_GREEDY,
+		BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_FIFO,
+		BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_HYBRID:
+		return nil
+	default:
+		return fmt.Errorf("unknown batch selection strategy: %s", v)
+	}
+}
+
+func validateBatchOldestShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("share must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateExecutedBatchStatsWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSignerSetPowerDiffThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("threshold must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateSignerSetMaxAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSlashFractionBadEthereumSignature(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateConflictingEthereumEventSlashing(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateConflictingEthereumEventGraceVotes(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateConflictingEthereumEventGraceWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEthereumEventVoteSlashingMode(i interface{}) error {
+	v, ok := i.(EthereumEventVoteSlashingMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	switch v {
+	case EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_DISABLED,
+		EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_JAIL,
+		EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_SLASH:
+		return nil
+	default:
+		return fmt.Errorf("invalid ethereum event vote slashing mode: %s", v)
+	}
+}
+
+func validateSlashFractionContractCallTx(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateBridgeSigningWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("bridge signing window must be positive")
+	}
+	return nil
+}
+
+func validateBridgeSigningMaxMissedRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max missed ratio must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateOracleLagEventThreshold(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinBridgeFees(i interface{}) error {
+	fees, ok := i.([]ERC20Token)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := map[common.Address]bool{}
+	for _, fee := range fees {
+		if !common.IsHexAddress(fee.Contract) {
+			return fmt.Errorf("not an ethereum address: %s", fee.Contract)
+		}
+		if fee.Amount.IsNil() || fee.Amount.IsNegative() {
+			return fmt.Errorf("invalid minimum bridge fee for %s", fee.Contract)
+		}
+		contract := common.HexToAddress(fee.Contract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate minimum bridge fee for %s", fee.Contract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateOutflowLimits(i interface{}) error {
+	limits, ok := i.([]OutflowLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := map[common.Address]bool{}
+	for _, limit := range limits {
+		if !common.IsHexAddress(limit.TokenContract) {
+			return fmt.Errorf("not an ethereum address: %s", limit.TokenContract)
+		}
+		if limit.Limit.IsNil() || limit.Limit.IsNegative() {
+			return fmt.Errorf("invalid outflow limit for %s", limit.TokenContract)
+		}
+		if limit.Window == 0 {
+			return fmt.Errorf("outflow window for %s cannot be zero", limit.TokenContract)
+		}
+		contract := common.HexToAddress(limit.TokenContract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate outflow limit for %s", limit.TokenContract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateBatchTriggers(i interface{}) error {
+	triggers, ok := i.([]BatchTrigger)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := map[common.Address]bool{}
+	for _, trigger := range triggers {
+		if !common.IsHexAddress(trigger.TokenContract) {
+			return fmt.Errorf("not an ethereum address: %s", trigger.TokenContract)
+		}
+		if trigger.MinFees.IsNil() || trigger.MinFees.IsNegative() {
+			return fmt.Errorf("invalid minimum fees for %s", trigger.TokenContract)
+		}
+		contract := common.HexToAddress(trigger.TokenContract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate batch trigger for %s", trigger.TokenContract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateBatchSettingsOverrides(i interface{}) error {
+	overrides, ok := i.([]BatchSettings)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := map[common.Address]bool{}
+	for _, override := range overrides {
+		if !common.IsHexAddress(override.TokenContract) {
+			return fmt.Errorf("not an ethereum address: %s", override.TokenContract)
+		}
+		if override.TargetEthTxTimeout != 0 {
+			if err := validateTargetEthTxTimeout(override.TargetEthTxTimeout); err != nil {
+				return sdkerrors.Wrap(err, override.TokenContract)
+			}
+		}
+		contract := common.HexToAddress(override.TokenContract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate batch settings override for %s", override.TokenContract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateInflowLimits(i interface{}) error {
+	limits, ok := i.([]InflowLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := map[common.Address]bool{}
+	for _, limit := range limits {
+		if !common.IsHexAddress(limit.TokenContract) {
+			return fmt.Errorf("not an ethereum address: %s", limit.TokenContract)
+		}
+		if limit.Limit.IsNil() || limit.Limit.IsNegative() {
+			return fmt.Errorf("invalid inflow limit for %s", limit.TokenContract)
+		}
+		if limit.Window == 0 {
+			return fmt.Errorf("inflow window for %s cannot be zero", limit.TokenContract)
+		}
+		contract := common.HexToAddress(limit.TokenContract)
+		if seen[contract] {
+			return fmt.Errorf("duplicate inflow limit for %s", limit.TokenContract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
@@ -25,6 +25,187 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BatchSelectionStrategy is how the SendToEthereums of a batch are picked
+type BatchSelectionStrategy int32
+
+const (
+	BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_UNSPECIFIED BatchSelectionStrategy = 0
+	// highest fees first
+	BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_FEE_GREEDY BatchSelectionStrategy = 1
+	// oldest transfers first
+	BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_FIFO BatchSelectionStrategy = 2
+	// a share of the slots for the oldest transfers, the rest by fee
+	BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_HYBRID BatchSelectionStrategy = 3
+)
+
+var BatchSelectionStrategy_name = map[int32]string{
+	0: "BATCH_SELECTION_STRATEGY_UNSPECIFIED",
+	1: "BATCH_SELECTION_STRATEGY_FEE_GREEDY",
+	2: "BATCH_SELECTION_STRATEGY_FIFO",
+	3: "BATCH_SELECTION_STRATEGY_HYBRID",
+}
+
+var BatchSelectionStrategy_value = map[string]int32{
+	"BATCH_SELECTION_STRATEGY_UNSPECIFIED": 0,
+	"BATCH_SELECTION_STRATEGY_FEE_GREEDY":  1,
+	"BATCH_SELECTION_STRATEGY_FIFO":        2,
+	"BATCH_SELECTION_STRATEGY_HYBRID":      3,
+}
+
+func (x BatchSelectionStrategy) String() string {
+	return proto.EnumName(BatchSelectionStrategy_name, int32(x))
+}
+
+func (BatchSelectionStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{0}
+}
+
+// EthereumEventVoteSlashingMode is how validators are penalised for not voting
+// on accepted events
+type EthereumEventVoteSlashingMode int32
+
+const (
+	EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_UNSPECIFIED EthereumEventVoteSlashingMode = 0
+	// not penalised
+	EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_DISABLED EthereumEventVoteSlashingMode = 1
+	// jailed without being slashed
+	EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_JAIL EthereumEventVoteSlashingMode = 2
+	// slashed and jailed
+	EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_SLASH EthereumEventVoteSlashingMode = 3
+)
+
+var EthereumEventVoteSlashingMode_name = map[int32]string{
+	0: "ETHEREUM_EVENT_VOTE_SLASHING_MODE_UNSPECIFIED",
+	1: "ETHEREUM_EVENT_VOTE_SLASHING_MODE_DISABLED",
+	2: "ETHEREUM_EVENT_VOTE_SLASHING_MODE_JAIL",
+	3: "ETHEREUM_EVENT_VOTE_SLASHING_MODE_SLASH",
+}
+
+var EthereumEventVoteSlashingMode_value = map[string]int32{
+	"ETHEREUM_EVENT_VOTE_SLASHING_MODE_UNSPECIFIED": 0,
+	"ETHEREUM_EVENT_VOTE_SLASHING_MODE_DISABLED":    1,
+	"ETHEREUM_EVENT_VOTE_SLASHING_MODE_JAIL":        2,
+	"ETHEREUM_EVENT_VOTE_SLASHING_MODE_SLASH":       3,
+}
+
+func (x EthereumEventVoteSlashingMode) String() string {
+	return proto.EnumName(EthereumEventVoteSlashingMode_name, int32(x))
+}
+
+func (EthereumEventVoteSlashingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{1}
+}
+
+// QuarantineStatus is the state of a deposit held in quarantine
+type QuarantineStatus int32
+
+const (
+	QuarantineStatus_QUARANTINE_STATUS_UNSPECIFIED QuarantineStatus = 0
+	// waiting for a governance decision
+	QuarantineStatus_QUARANTINE_STATUS_QUARANTINED QuarantineStatus = 1
+	// credited to the cosmos receiver
+	QuarantineStatus_QUARANTINE_STATUS_RELEASED QuarantineStatus = 2
+	// sent back to the ethereum sender
+	QuarantineStatus_QUARANTINE_STATUS_RETURNED QuarantineStatus = 3
+)
+
+var QuarantineStatus_name = map[int32]string{
+	0: "QUARANTINE_STATUS_UNSPECIFIED",
+	1: "QUARANTINE_STATUS_QUARANTINED",
+	2: "QUARANTINE_STATUS_RELEASED",
+	3: "QUARANTINE_STATUS_RETURNED",
+}
+
+var QuarantineStatus_value = map[string]int32{
+	"QUARANTINE_STATUS_UNSPECIFIED": 0,
+	"QUARANTINE_STATUS_QUARANTINED": 1,
+	"QUARANTINE_STATUS_RELEASED":    2,
+	"QUARANTINE_STATUS_RETURNED":    3,
+}
+
+func (x QuarantineStatus) String() string {
+	return proto.EnumName(QuarantineStatus_name, int32(x))
+}
+
+func (QuarantineStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+
+// SendToEthereumState is the stage of its lifecycle a SendToEthereum is at
+type SendToEthereumState int32
+
+const (
+	SendToEthereumState_SEND_TO_ETHEREUM_STATE_UNSPECIFIED SendToEthereumState = 0
+	// waiting in the pool to be batched
+	SendToEthereumState_SEND_TO_ETHEREUM_STATE_POOLED SendToEthereumState = 1
+	// part of a batch waiting to be executed on ethereum
+	SendToEthereumState_SEND_TO_ETHEREUM_STATE_BATCHED SendToEthereumState = 2
+	// executed on ethereum as part of a batch
+	SendToEthereumState_SEND_TO_ETHEREUM_STATE_EXECUTED SendToEthereumState = 3
+	// canceled by its sender
+	SendToEthereumState_SEND_TO_ETHEREUM_STATE_CANCELED SendToEthereumState = 4
+	// refunded to its sender by the bridge
+	SendToEthereumState_SEND_TO_ETHEREUM_STATE_REFUNDED SendToEthereumState = 5
+)
+
+var SendToEthereumState_name = map[int32]string{
+	0: "SEND_TO_ETHEREUM_STATE_UNSPECIFIED",
+	1: "SEND_TO_ETHEREUM_STATE_POOLED",
+	2: "SEND_TO_ETHEREUM_STATE_BATCHED",
+	3: "SEND_TO_ETHEREUM_STATE_EXECUTED",
+	4: "SEND_TO_ETHEREUM_STATE_CANCELED",
+	5: "SEND_TO_ETHEREUM_STATE_REFUNDED",
+}
+
+var SendToEthereumState_value = map[string]int32{
+	"SEND_TO_ETHEREUM_STATE_UNSPECIFIED": 0,
+	"SEND_TO_ETHEREUM_STATE_POOLED":      1,
+	"SEND_TO_ETHEREUM_STATE_BATCHED":     2,
+	"SEND_TO_ETHEREUM_STATE_EXECUTED":    3,
+	"SEND_TO_ETHEREUM_STATE_CANCELED":    4,
+	"SEND_TO_ETHEREUM_STATE_REFUNDED":    5,
+}
+
+func (x SendToEthereumState) String() string {
+	return proto.EnumName(SendToEthereumState_name, int32(x))
+}
+
+func (SendToEthereumState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+
+// OutgoingTxType is the type of an outgoing tx validators sign
+type OutgoingTxType int32
+
+const (
+	OutgoingTxType_OUTGOING_TX_TYPE_UNSPECIFIED   OutgoingTxType = 0
+	OutgoingTxType_OUTGOING_TX_TYPE_SIGNER_SET    OutgoingTxType = 1
+	OutgoingTxType_OUTGOING_TX_TYPE_BATCH         OutgoingTxType = 2
+	OutgoingTxType_OUTGOING_TX_TYPE_CONTRACT_CALL OutgoingTxType = 3
+)
+
+var OutgoingTxType_name = map[int32]string{
+	0: "OUTGOING_TX_TYPE_UNSPECIFIED",
+	1: "OUTGOING_TX_TYPE_SIGNER_SET",
+	2: "OUTGOING_TX_TYPE_BATCH",
+	3: "OUTGOING_TX_TYPE_CONTRACT_CALL",
+}
+
+var OutgoingTxType_value = map[string]int32{
+	"OUTGOING_TX_TYPE_UNSPECIFIED":   0,
+	"OUTGOING_TX_TYPE_SIGNER_SET":    1,
+	"OUTGOING_TX_TYPE_BATCH":         2,
+	"OUTGOING_TX_TYPE_CONTRACT_CALL": 3,
+}
+
+func (x OutgoingTxType) String() string {
+	return proto.EnumName(OutgoingTxType_name, int32(x))
+}
+
+func (OutgoingTxType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+
 // Params represent the Gravity genesis and store parameters
 // gravity_id:
 // a random 32 byte value to prevent signature reuse, for example if the
@@ -86,18 +267,137 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // The slashing fractions for the various gravity related slashing conditions.
 // The first three refer to not submitting a particular message, the third for
 // submitting a different ethereum_signature for the same Ethereum event
+//
+// send_to_ethereum_max_pool_age
+// send_to_ethereum_max_batch_timeouts
+//
+// Limits on how long a SendToEthereum may wait to be relayed. The first is the
+// number of blocks since the SendToEthereum entered the pool, the second the
+// number of batches containing it that timed out on Ethereum. Once either
+// limit is reached the SendToEthereum is refunded to its sender. A value of
+// zero disables the limit
+//
+// min_bridge_fees
+//
+// The minimum bridge fee, per ERC20 contract, a SendToEthereum must pay to
+// enter the pool. Tokens without an entry accept any fee
+//
+// outflow_limits
+//
+// Per-token limits on the amount, fees included, that may be batched for
+// Ethereum over a rolling window of blocks. Once a token reaches its limit,
+// new SendToEthereums of that token are paused for the length of the window
+//
+// inflow_limits
+//
+// Per-token limits on the amount deposited from Ethereum over a rolling window
+// of blocks. Deposits that would go over the limit are not credited but held
+// in quarantine until governance releases or returns them
+//
+// send_to_ethereum_status_retention
+//
+// Number of blocks the status of a SendToEthereum is kept once it was
+// executed, canceled or refunded. Zero keeps statuses forever
+//
+// batch_selection_strategy
+// batch_oldest_share
+//
+// How the SendToEthereums of a new batch are picked from the pool. Fee greedy
+// picks the highest fees first, FIFO the oldest transfers first, and hybrid
+// reserves batch_oldest_share of the batch slots for the oldest transfers and
+// fills the remaining slots by fee. batch_oldest_share is only used by hybrid
+//
+// batch_triggers
+//
+// Per-token conditions for creating batches automatically, checked every
+// block. A batch is created once the next batch would pay the minimum fees,
+// the pool holds the minimum number of transfers, or the oldest transfer
+// reached the maximum age, unless the token already has the maximum number of
+// outstanding batches. Tokens without a trigger, or whose trigger sets no
+// condition, are batched every batch_creation_period blocks
+//
+// batch_settings_overrides
+//
+// Per-token overrides of batch_max_element, target_eth_tx_timeout and
+// batch_creation_period, for tokens whose transfers cost more gas on Ethereum.
+// Zero values keep the global setting
+//
+// executed_batch_stats_window
+//
+// Number of executed batches per token whose stats are kept to estimate how
+// long pooled transfers wait and to suggest bridge fees. Zero stops recording
+// stats
+//
+// signer_set_power_diff_threshold
+//
+// Share of the bridge power that must move between the current validator set
+// and the latest signer set before a new signer set is created
+//
+// signer_set_max_age
+//
+// Number of blocks after which a new signer set is created even if the
+// validator set did not change. Zero never refreshes the signer set on age
+//
+// slash_fraction_bad_ethereum_signature
+//
+// The slashing fraction for signing over the checkpoint of an outgoing tx
+// the module never created, as proven by MsgSubmitBadSignatureEvidence
+//
+// conflicting_ethereum_event_slashing
+//
+// Whether validators are slashed by slash_fraction_conflicting_ethereum_signature
+// and jailed for voting for another event than the accepted one at an event
+// nonce
+//
+// conflicting_ethereum_event_grace_votes
+//
+// Number of conflicting votes a validator may cast within the grace window
+// before it is slashed, so that validators following a short-lived Ethereum
+// fork are not punished
+//
+// conflicting_ethereum_event_grace_window
+//
+// Number of blocks within which the conflicting votes of a validator are
+// counted against the grace votes
+//
+// ethereum_event_vote_slashing_mode
+//
+// How validators are penalised for not voting on an accepted event within
+// ethereum_signatures_window blocks of its acceptance: not at all, jailed, or
+// slashed by slash_fraction_ethereum_signature and jailed. Disabled by default,
+// governance turns it on
+//
+// slash_fraction_contract_call_tx
+//
+// # The slashing fraction for missing too many contract call signatures
+//
+// bridge_signing_window
+//
+// Number of outgoing txs a validator was last expected to sign that are
+// counted for its bridge signing info
+//
+// bridge_signing_max_missed_ratio
+//
+// Share of the bridge signing window a validator may miss before it is
+// slashed by the slash fraction of the outgoing tx type it missed last and
+// jailed
+//
+// oracle_lag_event_threshold
+//
+// Number of event nonces a bonded validator may fall behind the last observed
+// event nonce before an oracle_lagging event is emitted for it. Zero emits no
+// events
 type Params struct {
-	GravityId                string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
-	ContractSourceHash       string `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
-	BridgeEthereumAddress    string `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
-	BridgeChainId            uint64 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
-	SignedSignerSetTxsWindow uint64 `protobuf:"varint,6,opt,name=signed_signer_set_txs_window,json=signedSignerSetTxsWindow,proto3" json:"signed_signer_set_txs_window,omitempty"`
-	SignedBatchesWindow      uint64 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
-	EthereumSignaturesWindow uint64 `protobuf:"varint,8,opt,name=ethereum_signatures_window,json=ethereumSignaturesWindow,proto3" json:"ethereum_signatures_window,omitempty"`
-	TargetEthTxTimeout       uint64 `protobuf:"varint,10,opt,name=target_eth_tx_timeout,json=targetEthTxTimeout,proto3" json:"target_eth_tx_timeout,omitempty"`
-	AverageBlockTime         uint64 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
-	AverageEthereumBlockTime uint64 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	// TODO: slash fraction for contract call txs too
+	GravityId                                 string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	ContractSourceHash                        string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
+	BridgeEthereumAddress                     string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	BridgeChainId                             uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	SignedSignerSetTxsWindow                  uint64                                 `protobuf:"varint,6,opt,name=signed_signer_set_txs_window,json=signedSignerSetTxsWindow,proto3" json:"signed_signer_set_txs_window,omitempty"`
+	SignedBatchesWindow                       uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+	EthereumSignaturesWindow                  uint64                                 `protobuf:"varint,8,opt,name=ethereum_signatures_window,json=ethereumSignaturesWindow,proto3" json:"ethereum_signatures_window,omitempty"`
+	TargetEthTxTimeout                        uint64                                 `protobuf:"varint,10,opt,name=target_eth_tx_timeout,json=targetEthTxTimeout,proto3" json:"target_eth_tx_timeout,omitempty"`
+	AverageBlockTime                          uint64                                 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
+	AverageEthereumBlockTime                  uint64                                 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
 	SlashFractionSignerSetTx                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_signer_set_tx,json=slashFractionSignerSetTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_signer_set_tx"`
 	SlashFractionBatch                        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
 	SlashFractionEthereumSignature            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_ethereum_signature,json=slashFractionEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_ethereum_signature"`
@@ -107,6 +407,28 @@ type Params struct {
 	BatchCreationPeriod                       uint64                                 `protobuf:"varint,19,opt,name=batch_creation_period,json=batchCreationPeriod,proto3" json:"batch_creation_period,omitempty"`
 	BatchMaxElement                           uint64                                 `protobuf:"varint,20,opt,name=batch_max_element,json=batchMaxElement,proto3" json:"batch_max_element,omitempty"`
 	ObserveEthereumHeightPeriod               uint64                                 `protobuf:"varint,21,opt,name=observe_ethereum_height_period,json=observeEthereumHeightPeriod,proto3" json:"observe_ethereum_height_period,omitempty"`
+	SendToEthereumMaxPoolAge                  uint64                                 `protobuf:"varint,22,opt,name=send_to_ethereum_max_pool_age,json=sendToEthereumMaxPoolAge,proto3" json:"send_to_ethereum_max_pool_age,omitempty"`
+	SendToEthereumMaxBatchTimeouts            uint64                                 `protobuf:"varint,23,opt,name=send_to_ethereum_max_batch_timeouts,json=sendToEthereumMaxBatchTimeouts,proto3" json:"send_to_ethereum_max_batch_timeouts,omitempty"`
+	MinBridgeFees                             []ERC20Token                           `protobuf:"bytes,24,rep,name=min_bridge_fees,json=minBridgeFees,proto3" json:"min_bridge_fees"`
+	OutflowLimits                             []OutflowLimit                         `protobuf:"bytes,25,rep,name=outflow_limits,json=outflowLimits,proto3" json:"outflow_limits"`
+	InflowLimits                              []InflowLimit                          `protobuf:"bytes,26,rep,name=inflow_limits,json=inflowLimits,proto3" json:"inflow_limits"`
+	SendToEthereumStatusRetention             uint64                                 `protobuf:"varint,27,opt,name=send_to_ethereum_status_retention,json=sendToEthereumStatusRetention,proto3" json:"send_to_ethereum_status_retention,omitempty"`
+	BatchSelectionStrategy                    BatchSelectionStrategy                 `protobuf:"varint,28,opt,name=batch_selection_strategy,json=batchSelectionStrategy,proto3,enum=gravity.v1.BatchSelectionStrategy" json:"batch_selection_strategy,omitempty"`
+	BatchOldestShare                          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,29,opt,name=batch_oldest_share,json=batchOldestShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"batch_oldest_share"`
+	BatchTriggers                             []BatchTrigger                         `protobuf:"bytes,30,rep,name=batch_triggers,json=batchTriggers,proto3" json:"batch_triggers"`
+	BatchSettingsOverrides                    []BatchSettings                        `protobuf:"bytes,31,rep,name=batch_settings_overrides,json=batchSettingsOverrides,proto3" json:"batch_settings_overrides"`
+	ExecutedBatchStatsWindow                  uint64                                 `protobuf:"varint,32,opt,name=executed_batch_stats_window,json=executedBatchStatsWindow,proto3" json:"executed_batch_stats_window,omitempty"`
+	SignerSetPowerDiffThreshold               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,33,opt,name=signer_set_power_diff_threshold,json=signerSetPowerDiffThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"signer_set_power_diff_threshold"`
+	SignerSetMaxAge                           uint64                                 `protobuf:"varint,34,opt,name=signer_set_max_age,json=signerSetMaxAge,proto3" json:"signer_set_max_age,omitempty"`
+	SlashFractionBadEthereumSignature         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,35,opt,name=slash_fraction_bad_ethereum_signature,json=slashFractionBadEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_ethereum_signature"`
+	ConflictingEthereumEventSlashing          bool                                   `protobuf:"varint,36,opt,name=conflicting_ethereum_event_slashing,json=conflictingEthereumEventSlashing,proto3" json:"conflicting_ethereum_event_slashing,omitempty"`
+	ConflictingEthereumEventGraceVotes        uint64                                 `protobuf:"varint,37,opt,name=conflicting_ethereum_event_grace_votes,json=conflictingEthereumEventGraceVotes,proto3" json:"conflicting_ethereum_event_grace_votes,omitempty"`
+	ConflictingEthereumEventGraceWindow       uint64                                 `protobuf:"varint,38,opt,name=conflicting_ethereum_event_grace_window,json=conflictingEthereumEventGraceWindow,proto3" json:"conflicting_ethereum_event_grace_window,omitempty"`
+	EthereumEventVoteSlashingMode             EthereumEventVoteSlashingMode          `protobuf:"varint,39,opt,name=ethereum_event_vote_slashing_mode,json=ethereumEventVoteSlashingMode,proto3,enum=gravity.v1.EthereumEventVoteSlashingMode" json:"ethereum_event_vote_slashing_mode,omitempty"`
+	SlashFractionContractCallTx               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,40,opt,name=slash_fraction_contract_call_tx,json=slashFractionContractCallTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_contract_call_tx"`
+	BridgeSigningWindow                       uint64                                 `protobuf:"varint,41,opt,name=bridge_signing_window,json=bridgeSigningWindow,proto3" json:"bridge_signing_window,omitempty"`
+	BridgeSigningMaxMissedRatio               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,42,opt,name=bridge_signing_max_missed_ratio,json=bridgeSigningMaxMissedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bridge_signing_max_missed_ratio"`
+	OracleLagEventThreshold                   uint64                                 `protobuf:"varint,43,opt,name=oracle_lag_event_threshold,json=oracleLagEventThreshold,proto3" json:"oracle_lag_event_threshold,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -247,18 +569,158 @@ func (m *Params) GetObserveEthereumHeightPeriod() uint64 {
 	return 0
 }
 
+func (m *Params) GetSendToEthereumMaxPoolAge() uint64 {
+	if m != nil {
+		return m.SendToEthereumMaxPoolAge
+	}
+	return 0
+}
+
+func (m *Params) GetSendToEthereumMaxBatchTimeouts() uint64 {
+	if m != nil {
+		return m.SendToEthereumMaxBatchTimeouts
+	}
+	return 0
+}
+
+func (m *Params) GetMinBridgeFees() []ERC20Token {
+	if m != nil {
+		return m.MinBridgeFees
+	}
+	return nil
+}
+
+func (m *Params) GetOutflowLimits() []OutflowLimit {
+	if m != nil {
+		return m.OutflowLimits
+	}
+	return nil
+}
+
+func (m *Params) GetInflowLimits() []InflowLimit {
+	if m != nil {
+		return m.InflowLimits
+	}
+	return nil
+}
+
+func (m *Params) GetSendToEthereumStatusRetention() uint64 {
+	if m != nil {
+		return m.SendToEthereumStatusRetention
+	}
+	return 0
+}
+
+func (m *Params) GetBatchSelectionStrategy() BatchSelectionStrategy {
+	if m != nil {
+		return m.BatchSelectionStrategy
+	}
+	return BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_UNSPECIFIED
+}
+
+func (m *Params) GetBatchTriggers() []BatchTrigger {
+	if m != nil {
+		return m.BatchTriggers
+	}
+	return nil
+}
+
+func (m *Params) GetBatchSettingsOverrides() []BatchSettings {
+	if m != nil {
+		return m.BatchSettingsOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetExecutedBatchStatsWindow() uint64 {
+	if m != nil {
+		return m.ExecutedBatchStatsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSignerSetMaxAge() uint64 {
+	if m != nil {
+		return m.SignerSetMaxAge
+	}
+	return 0
+}
+
+func (m *Params) GetConflictingEthereumEventSlashing() bool {
+	if m != nil {
+		return m.ConflictingEthereumEventSlashing
+	}
+	return false
+}
+
+func (m *Params) GetConflictingEthereumEventGraceVotes() uint64 {
+	if m != nil {
+		return m.ConflictingEthereumEventGraceVotes
+	}
+	return 0
+}
+
+func (m *Params) GetConflictingEthereumEventGraceWindow() uint64 {
+	if m != nil {
+		return m.ConflictingEthereumEventGraceWindow
+	}
+	return 0
+}
+
+func (m *Params) GetEthereumEventVoteSlashingMode() EthereumEventVoteSlashingMode {
+	if m != nil {
+		return m.EthereumEventVoteSlashingMode
+	}
+	return EthereumEventVoteSlashingMode_ETHEREUM_EVENT_VOTE_SLASHING_MODE_UNSPECIFIED
+}
+
+func (m *Params) GetBridgeSigningWindow() uint64 {
+	if m != nil {
+		return m.BridgeSigningWindow
+	}
+	return 0
+}
+
+func (m *Params) GetOracleLagEventThreshold() uint64 {
+	if m != nil {
+		return m.OracleLagEventThreshold
+	}
+	return 0
+}
+
 // GenesisState struct
 // TODO: this need to be audited and potentially simplified using the new
 // interfaces
 type GenesisState struct {
-	Params                     *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedEventNonce     uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
-	OutgoingTxs                []*types.Any               `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
-	Confirmations              []*types.Any               `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
-	EthereumEventVoteRecords   []*EthereumEventVoteRecord `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
-	DelegateKeys               []*MsgDelegateKeys         `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms              []*ERC20ToDenom            `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedSendToEthereumTxs []*SendToEthereum          `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	Params                               *Params                        `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedEventNonce               uint64                         `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	OutgoingTxs                          []*types.Any                   `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
+	Confirmations                        []*types.Any                   `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	EthereumEventVoteRecords             []*EthereumEventVoteRecord     `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
+	DelegateKeys                         []*MsgDelegateKeys             `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms                        []*ERC20ToDenom                `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedSendToEthereumTxs           []*SendToEthereum              `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	QuarantinedDeposits                  []*QuarantinedDeposit          `protobuf:"bytes,13,rep,name=quarantined_deposits,json=quarantinedDeposits,proto3" json:"quarantined_deposits,omitempty"`
+	EthereumDenylist                     []string                       `protobuf:"bytes,14,rep,name=ethereum_denylist,json=ethereumDenylist,proto3" json:"ethereum_denylist,omitempty"`
+	SendToEthereumStatuses               []*SendToEthereumStatus        `protobuf:"bytes,15,rep,name=send_to_ethereum_statuses,json=sendToEthereumStatuses,proto3" json:"send_to_ethereum_statuses,omitempty"`
+	ExecutedBatchStats                   []*ExecutedBatchStats          `protobuf:"bytes,16,rep,name=executed_batch_stats,json=executedBatchStats,proto3" json:"executed_batch_stats,omitempty"`
+	SignerSetHashes                      []*SignerSetHash               `protobuf:"bytes,17,rep,name=signer_set_hashes,json=signerSetHashes,proto3" json:"signer_set_hashes,omitempty"`
+	SignerSetHijackIncidents             []*SignerSetHijackIncident     `protobuf:"bytes,18,rep,name=signer_set_hijack_incidents,json=signerSetHijackIncidents,proto3" json:"signer_set_hijack_incidents,omitempty"`
+	EthereumSignatureCheckpoints         [][]byte                       `protobuf:"bytes,19,rep,name=ethereum_signature_checkpoints,json=ethereumSignatureCheckpoints,proto3" json:"ethereum_signature_checkpoints,omitempty"`
+	BadSignatureEvidence                 []*BadSignatureEvidence        `protobuf:"bytes,20,rep,name=bad_signature_evidence,json=badSignatureEvidence,proto3" json:"bad_signature_evidence,omitempty"`
+	BadSignatureEvidenceFloor            *BadSignatureEvidenceFloor     `protobuf:"bytes,21,opt,name=bad_signature_evidence_floor,json=badSignatureEvidenceFloor,proto3" json:"bad_signature_evidence_floor,omitempty"`
+	ConflictingEventVotes                []*ConflictingEventVote        `protobuf:"bytes,22,rep,name=conflicting_event_votes,json=conflictingEventVotes,proto3" json:"conflicting_event_votes,omitempty"`
+	EthereumEventAcceptedHeights         []*EthereumEventAcceptedHeight `protobuf:"bytes,23,rep,name=ethereum_event_accepted_heights,json=ethereumEventAcceptedHeights,proto3" json:"ethereum_event_accepted_heights,omitempty"`
+	EthereumEventExcusedNonces           []*EthereumEventExcusedNonce   `protobuf:"bytes,24,rep,name=ethereum_event_excused_nonces,json=ethereumEventExcusedNonces,proto3" json:"ethereum_event_excused_nonces,omitempty"`
+	LastBridgeInactiveHeight             uint64                         `protobuf:"varint,25,opt,name=last_bridge_inactive_height,json=lastBridgeInactiveHeight,proto3" json:"last_bridge_inactive_height,omitempty"`
+	BridgeSigningInfos                   []*BridgeSigningInfo           `protobuf:"bytes,26,rep,name=bridge_signing_infos,json=bridgeSigningInfos,proto3" json:"bridge_signing_infos,omitempty"`
+	LaggingOracleValidators              []string                       `protobuf:"bytes,27,rep,name=lagging_oracle_validators,json=laggingOracleValidators,proto3" json:"lagging_oracle_validators,omitempty"`
+	SendToEthereumPoolRecords            []*SendToEthereumPoolRecord    `protobuf:"bytes,28,rep,name=send_to_ethereum_pool_records,json=sendToEthereumPoolRecords,proto3" json:"send_to_ethereum_pool_records,omitempty"`
+	Outflows                             []*WindowAmount                `protobuf:"bytes,29,rep,name=outflows,proto3" json:"outflows,omitempty"`
+	OutflowPauses                        []*OutflowPause                `protobuf:"bytes,30,rep,name=outflow_pauses,json=outflowPauses,proto3" json:"outflow_pauses,omitempty"`
+	Inflows                              []*WindowAmount                `protobuf:"bytes,31,rep,name=inflows,proto3" json:"inflows,omitempty"`
+	LastSignerSetChangeBlockHeight       uint64                         `protobuf:"varint,32,opt,name=last_signer_set_change_block_height,json=lastSignerSetChangeBlockHeight,proto3" json:"last_signer_set_change_block_height,omitempty"`
+	UntrackedEthereumEventAcceptedHeight uint64                         `protobuf:"varint,33,opt,name=untracked_ethereum_event_accepted_height,json=untrackedEthereumEventAcceptedHeight,proto3" json:"untracked_ethereum_event_accepted_height,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -350,609 +812,7957 @@ func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
 	return nil
 }
 
-// This records the relationship between an ERC20 token and the denom
-// of the corresponding Cosmos originated asset
-type ERC20ToDenom struct {
-	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+func (m *GenesisState) GetQuarantinedDeposits() []*QuarantinedDeposit {
+	if m != nil {
+		return m.QuarantinedDeposits
+	}
+	return nil
 }
 
-func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
-func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
-func (*ERC20ToDenom) ProtoMessage()    {}
-func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{2}
-}
-func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *GenesisState) GetEthereumDenylist() []string {
+	if m != nil {
+		return m.EthereumDenylist
 	}
+	return nil
 }
-func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
-}
-func (m *ERC20ToDenom) XXX_Size() int {
-	return m.Size()
-}
-func (m *ERC20ToDenom) XXX_DiscardUnknown() {
-	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
 
-func (m *ERC20ToDenom) GetErc20() string {
+func (m *GenesisState) GetSendToEthereumStatuses() []*SendToEthereumStatus {
 	if m != nil {
-		return m.Erc20
+		return m.SendToEthereumStatuses
 	}
-	return ""
+	return nil
 }
 
-func (m *ERC20ToDenom) GetDenom() string {
+func (m *GenesisState) GetExecutedBatchStats() []*ExecutedBatchStats {
 	if m != nil {
-		return m.Denom
+		return m.ExecutedBatchStats
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+func (m *GenesisState) GetSignerSetHashes() []*SignerSetHash {
+	if m != nil {
+		return m.SignerSetHashes
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+func (m *GenesisState) GetSignerSetHijackIncidents() []*SignerSetHijackIncident {
+	if m != nil {
+		return m.SignerSetHijackIncidents
+	}
+	return nil
+}
 
-var fileDescriptor_387b0aba880adb60 = []byte{
-	// 1002 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0x8e, 0x69, 0x1a, 0xda, 0x89, 0x43, 0xda, 0xa9, 0x03, 0xdb, 0xa4, 0xb8, 0x26, 0x15, 0x55,
-	0xa8, 0x88, 0x9d, 0x18, 0x09, 0x44, 0xf8, 0x51, 0xf3, 0x07, 0xad, 0x50, 0x69, 0xb5, 0x36, 0x20,
-	0x71, 0xc1, 0x30, 0xde, 0x39, 0xd9, 0x5d, 0xe2, 0x9d, 0x89, 0x76, 0xc6, 0xae, 0x7d, 0xc7, 0x03,
-	0x70, 0xd1, 0x67, 0xe1, 0x29, 0x7a, 0xd9, 0x4b, 0x84, 0x50, 0x85, 0x92, 0x17, 0x41, 0x73, 0x66,
-	0xd6, 0x59, 0xbb, 0xe1, 0x26, 0x57, 0xf6, 0x9c, 0xef, 0xfb, 0xce, 0x77, 0xf6, 0x9c, 0x9d, 0xb3,
-	0x24, 0x88, 0x73, 0x3e, 0x4c, 0xcd, 0xb8, 0x35, 0xdc, 0x6e, 0xc5, 0x20, 0x41, 0xa7, 0xba, 0x79,
-	0x92, 0x2b, 0xa3, 0x28, 0xf1, 0x48, 0x73, 0xb8, 0xbd, 0x5a, 0x8b, 0x55, 0xac, 0x30, 0xdc, 0xb2,
-	0xff, 0x1c, 0x63, 0xf5, 0x76, 0xac, 0x54, 0xdc, 0x87, 0x16, 0x9e, 0x7a, 0x83, 0xa3, 0x16, 0x97,
-	0x63, 0x0f, 0x4d, 0xa5, 0xf5, 0x79, 0x1c, 0xb2, 0x52, 0x42, 0x32, 0x1d, 0x7b, 0xb7, 0xf5, 0x3f,
-	0x08, 0x59, 0x78, 0xc6, 0x73, 0x9e, 0x69, 0xfa, 0x3e, 0x29, 0xac, 0x59, 0x2a, 0x82, 0x4a, 0xa3,
-	0xb2, 0x71, 0x3d, 0xbc, 0xee, 0x23, 0x8f, 0x05, 0xdd, 0x22, 0xb5, 0x48, 0x49, 0x93, 0xf3, 0xc8,
-	0x30, 0xad, 0x06, 0x79, 0x04, 0x2c, 0xe1, 0x3a, 0x09, 0xde, 0x42, 0x22, 0x2d, 0xb0, 0x0e, 0x42,
-	0x8f, 0xb8, 0x4e, 0xe8, 0xa7, 0xe4, 0xbd, 0x5e, 0x9e, 0x8a, 0x18, 0x18, 0x98, 0x04, 0x72, 0x18,
-	0x64, 0x8c, 0x0b, 0x91, 0x83, 0xd6, 0xc1, 0x3c, 0x8a, 0x56, 0x1c, 0x7c, 0xe8, 0xd1, 0x5d, 0x07,
-	0xd2, 0xfb, 0x64, 0xd9, 0xeb, 0xa2, 0x84, 0xa7, 0xd2, 0x56, 0x73, 0xb5, 0x51, 0xd9, 0x98, 0x0f,
-	0x97, 0x5c, 0x78, 0xdf, 0x46, 0x1f, 0x0b, 0xfa, 0x35, 0xb9, 0xa3, 0xd3, 0x58, 0x82, 0x60, 0xf8,
-	0x93, 0x33, 0x0d, 0x86, 0x99, 0x91, 0x66, 0xcf, 0x53, 0x29, 0xd4, 0xf3, 0x60, 0x01, 0x45, 0x81,
-	0xe3, 0x74, 0x90, 0xd2, 0x01, 0xd3, 0x1d, 0xe9, 0x9f, 0x10, 0xa7, 0x6d, 0xb2, 0xe2, 0xf5, 0x3d,
-	0x6e, 0xa2, 0x04, 0x26, 0xc2, 0xb7, 0x51, 0x78, 0xcb, 0x81, 0x7b, 0x0e, 0xf3, 0x9a, 0x2f, 0xc9,
-	0xea, 0xe4, 0x61, 0x2c, 0xce, 0xcd, 0x20, 0x3f, 0x17, 0x5e, 0x73, 0x8e, 0x05, 0xa3, 0x33, 0x21,
-	0x78, 0xf5, 0x36, 0x59, 0x31, 0x3c, 0x8f, 0xc1, 0xd8, 0x8e, 0x30, 0x33, 0x62, 0x26, 0xcd, 0x40,
-	0x0d, 0x4c, 0x40, 0x50, 0x48, 0x1d, 0x78, 0x68, 0x92, 0xee, 0xa8, 0xeb, 0x10, 0xfa, 0x31, 0xa1,
-	0x7c, 0x08, 0x39, 0x8f, 0x81, 0xf5, 0xfa, 0x2a, 0x3a, 0x46, 0x49, 0xb0, 0x88, 0xfc, 0x1b, 0x1e,
-	0xd9, 0xb3, 0x80, 0x15, 0xd0, 0xaf, 0xc8, 0x5a, 0xc1, 0x9e, 0x94, 0x59, 0x92, 0x55, 0x5d, 0x7d,
-	0x9e, 0x52, 0xf4, 0xfd, 0x5c, 0x2e, 0xc9, 0x1d, 0xdd, 0xe7, 0x3a, 0x61, 0x47, 0x76, 0x94, 0xa9,
-	0x92, 0xd3, 0x9d, 0x0d, 0x96, 0x1a, 0x95, 0x8d, 0xea, 0x5e, 0xf3, 0xe5, 0xeb, 0xbb, 0x73, 0x7f,
-	0xbf, 0xbe, 0x7b, 0x3f, 0x4e, 0x4d, 0x32, 0xe8, 0x35, 0x23, 0x95, 0xb5, 0x22, 0xa5, 0x33, 0xa5,
-	0xfd, 0xcf, 0xa6, 0x16, 0xc7, 0x2d, 0x33, 0x3e, 0x01, 0xdd, 0x3c, 0x80, 0x28, 0x0c, 0x30, 0xe7,
-	0x37, 0x3e, 0x65, 0x69, 0x10, 0xf4, 0x57, 0x52, 0x9b, 0xf1, 0xc3, 0x49, 0x04, 0xef, 0x5c, 0xca,
-	0x87, 0x4e, 0xf9, 0xe0, 0xdc, 0xe8, 0x98, 0x7c, 0x30, 0xe3, 0xf0, 0xe6, 0xf8, 0x82, 0xe5, 0x4b,
-	0xd9, 0xd5, 0xa7, 0xec, 0x0e, 0x67, 0x67, 0x4e, 0x5f, 0x54, 0xc8, 0xe6, 0x8c, 0x77, 0xa4, 0xe4,
-	0x51, 0x3f, 0x8d, 0x4c, 0x2a, 0xe3, 0x8b, 0xea, 0xb8, 0x71, 0xa9, 0x3a, 0x3e, 0x9a, 0xaa, 0x63,
-	0xff, 0xdc, 0xe2, 0xcd, 0x92, 0x9e, 0x92, 0x0f, 0x07, 0xb2, 0xa7, 0xa4, 0x60, 0xa8, 0xb1, 0x65,
-	0x5c, 0x7c, 0x75, 0x6e, 0xe2, 0x8b, 0xd2, 0x70, 0xe4, 0x8e, 0xe7, 0x5e, 0x70, 0x85, 0xee, 0x11,
-	0x7f, 0x27, 0x99, 0x75, 0x1f, 0x42, 0x40, 0x1b, 0x95, 0x8d, 0x6b, 0x61, 0xd5, 0x05, 0x77, 0x31,
-	0x66, 0xef, 0x19, 0x8e, 0x95, 0x45, 0x39, 0x70, 0xec, 0xc3, 0x09, 0xe4, 0xa9, 0x12, 0xc1, 0x2d,
-	0x77, 0xcf, 0x10, 0xdc, 0xf7, 0xd8, 0x33, 0x84, 0xe8, 0x03, 0x72, 0xd3, 0x69, 0x32, 0x3e, 0x62,
-	0xd0, 0x87, 0x0c, 0xa4, 0x09, 0x6a, 0xc8, 0x5f, 0x46, 0xe0, 0x09, 0x1f, 0x1d, 0xba, 0x30, 0xdd,
-	0x27, 0x75, 0xd5, 0xd3, 0x90, 0x0f, 0x4b, 0x2f, 0x7d, 0x02, 0x69, 0x9c, 0x98, 0xc2, 0x68, 0x05,
-	0x85, 0x6b, 0x9e, 0x55, 0xf4, 0xe5, 0x11, 0x72, 0x9c, 0xe1, 0xce, 0xfc, 0xef, 0xff, 0x34, 0xe6,
-	0xd6, 0xff, 0x9c, 0x27, 0xd5, 0x6f, 0xdd, 0x3a, 0xee, 0x18, 0x6e, 0x80, 0x3e, 0x20, 0x0b, 0x27,
-	0xb8, 0x1e, 0x71, 0x21, 0x2e, 0xb6, 0x69, 0xf3, 0x7c, 0x3d, 0x37, 0xdd, 0xe2, 0x0c, 0x3d, 0x83,
-	0x7e, 0x4e, 0x6e, 0xf7, 0xb9, 0x36, 0xcc, 0xdb, 0x08, 0x06, 0x43, 0x90, 0x86, 0x49, 0x25, 0x23,
-	0xc0, 0x35, 0x39, 0x1f, 0xbe, 0x6b, 0x09, 0x4f, 0x3d, 0x7e, 0x68, 0xe1, 0xef, 0x2d, 0x4a, 0x3f,
-	0x23, 0x55, 0x35, 0x30, 0xb1, 0xb2, 0x13, 0x31, 0x23, 0x1d, 0x5c, 0x69, 0x5c, 0xd9, 0x58, 0x6c,
-	0xd7, 0x9a, 0x6e, 0xd3, 0x37, 0x8b, 0x4d, 0xdf, 0xdc, 0x95, 0xe3, 0x70, 0xb1, 0x60, 0x76, 0x47,
-	0x9a, 0xee, 0x90, 0x25, 0xfb, 0x52, 0xa5, 0x79, 0x86, 0xdd, 0xb3, 0x9b, 0xf5, 0xff, 0x95, 0xd3,
-	0x54, 0xda, 0x23, 0x6b, 0x93, 0x7e, 0xb9, 0x52, 0x87, 0xca, 0x00, 0xcb, 0x21, 0x52, 0xb9, 0xd0,
-	0xc1, 0x75, 0xcc, 0x74, 0xaf, 0xfc, 0xc0, 0x45, 0xe7, 0xb0, 0xf2, 0x1f, 0x95, 0x81, 0x10, 0xb9,
-	0xe7, 0x1b, 0x6f, 0x06, 0xd0, 0xf4, 0x21, 0x59, 0x12, 0xd0, 0x87, 0x98, 0x1b, 0x60, 0xc7, 0x30,
-	0xd6, 0x01, 0xc1, 0xac, 0x6b, 0xe5, 0xac, 0x4f, 0x74, 0x7c, 0xe0, 0x39, 0xdf, 0xc1, 0x58, 0x87,
-	0x55, 0x51, 0x3a, 0xd1, 0x87, 0x64, 0x19, 0xf2, 0xa8, 0xbd, 0xc5, 0x8c, 0x62, 0x02, 0xa4, 0xca,
-	0x74, 0xb0, 0x88, 0x39, 0x82, 0xa9, 0xca, 0xc2, 0xfd, 0xf6, 0x56, 0x57, 0x1d, 0x58, 0x42, 0xb8,
-	0x84, 0x02, 0x7f, 0xd2, 0xf4, 0x17, 0x52, 0x1f, 0x48, 0xb7, 0xe2, 0x05, 0xd3, 0x20, 0x85, 0x4d,
-	0x35, 0x79, 0x72, 0xdb, 0xee, 0x2a, 0x26, 0x5c, 0x2d, 0x27, 0xec, 0x80, 0x14, 0x5d, 0x55, 0x3c,
-	0x70, 0xb8, 0x3a, 0xc9, 0x30, 0x0d, 0x74, 0x47, 0x7a, 0x7d, 0x87, 0x54, 0xcb, 0xf6, 0xb4, 0x46,
-	0xae, 0x62, 0x01, 0xfe, 0x1b, 0xea, 0x0e, 0x36, 0x8a, 0xe5, 0xfb, 0x0f, 0xa6, 0x3b, 0xec, 0xfd,
-	0xf0, 0xf2, 0xb4, 0x5e, 0x79, 0x75, 0x5a, 0xaf, 0xfc, 0x7b, 0x5a, 0xaf, 0xbc, 0x38, 0xab, 0xcf,
-	0xbd, 0x3a, 0xab, 0xcf, 0xfd, 0x75, 0x56, 0x9f, 0xfb, 0xf9, 0x8b, 0xd2, 0xf5, 0x3f, 0x81, 0x38,
-	0x1e, 0xff, 0x36, 0x2c, 0x3e, 0xe9, 0x9b, 0xee, 0x7a, 0xb5, 0x32, 0x25, 0x06, 0x7d, 0x68, 0x0d,
-	0xdb, 0xad, 0x51, 0x01, 0xb9, 0xbd, 0xd0, 0x5b, 0xc0, 0xb9, 0x7f, 0xf2, 0x5f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x8a, 0x8b, 0x04, 0xb4, 0x67, 0x08, 0x00, 0x00,
+func (m *GenesisState) GetEthereumSignatureCheckpoints() [][]byte {
+	if m != nil {
+		return m.EthereumSignatureCheckpoints
+	}
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetBadSignatureEvidence() []*BadSignatureEvidence {
+	if m != nil {
+		return m.BadSignatureEvidence
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetBadSignatureEvidenceFloor() *BadSignatureEvidenceFloor {
+	if m != nil {
+		return m.BadSignatureEvidenceFloor
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ObserveEthereumHeightPeriod != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.ObserveEthereumHeightPeriod))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
-	}
-	if m.BatchMaxElement != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchMaxElement))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
-	}
-	if m.BatchCreationPeriod != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchCreationPeriod))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
-	}
-	if m.BridgeActive {
-		i--
-		if m.BridgeActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.UnbondSlashingSignerSetTxsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	{
-		size := m.SlashFractionConflictingEthereumSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionConflictingEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+func (m *GenesisState) GetConflictingEventVotes() []*ConflictingEventVote {
+	if m != nil {
+		return m.ConflictingEventVotes
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.SlashFractionEthereumSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetEthereumEventAcceptedHeights() []*EthereumEventAcceptedHeight {
+	if m != nil {
+		return m.EthereumEventAcceptedHeights
 	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetEthereumEventExcusedNonces() []*EthereumEventExcusedNonce {
+	if m != nil {
+		return m.EthereumEventExcusedNonces
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.SlashFractionSignerSetTx.Size()
-		i -= size
-		if _, err := m.SlashFractionSignerSetTx.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetLastBridgeInactiveHeight() uint64 {
+	if m != nil {
+		return m.LastBridgeInactiveHeight
 	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
-		i--
-		dAtA[i] = 0x60
+	return 0
+}
+
+func (m *GenesisState) GetBridgeSigningInfos() []*BridgeSigningInfo {
+	if m != nil {
+		return m.BridgeSigningInfos
 	}
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
-		i--
-		dAtA[i] = 0x58
+	return nil
+}
+
+func (m *GenesisState) GetLaggingOracleValidators() []string {
+	if m != nil {
+		return m.LaggingOracleValidators
 	}
-	if m.TargetEthTxTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetEthTxTimeout))
-		i--
-		dAtA[i] = 0x50
+	return nil
+}
+
+func (m *GenesisState) GetSendToEthereumPoolRecords() []*SendToEthereumPoolRecord {
+	if m != nil {
+		return m.SendToEthereumPoolRecords
 	}
-	if m.EthereumSignaturesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumSignaturesWindow))
-		i--
-		dAtA[i] = 0x40
+	return nil
+}
+
+func (m *GenesisState) GetOutflows() []*WindowAmount {
+	if m != nil {
+		return m.Outflows
 	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
-		i--
-		dAtA[i] = 0x38
+	return nil
+}
+
+func (m *GenesisState) GetOutflowPauses() []*OutflowPause {
+	if m != nil {
+		return m.OutflowPauses
 	}
-	if m.SignedSignerSetTxsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedSignerSetTxsWindow))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *GenesisState) GetInflows() []*WindowAmount {
+	if m != nil {
+		return m.Inflows
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *GenesisState) GetLastSignerSetChangeBlockHeight() uint64 {
+	if m != nil {
+		return m.LastSignerSetChangeBlockHeight
 	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *GenesisState) GetUntrackedEthereumEventAcceptedHeight() uint64 {
+	if m != nil {
+		return m.UntrackedEthereumEventAcceptedHeight
 	}
-	if len(m.ContractSourceHash) > 0 {
-		i -= len(m.ContractSourceHash)
-		copy(dAtA[i:], m.ContractSourceHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+// This records the relationship between an ERC20 token and the denom
+// of the corresponding Cosmos originated asset
+type ERC20ToDenom struct {
+	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
+func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
+func (*ERC20ToDenom) ProtoMessage()    {}
+func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.GravityId) > 0 {
-		i -= len(m.GravityId)
-		copy(dAtA[i:], m.GravityId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
+}
+func (m *ERC20ToDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20ToDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
+
+func (m *ERC20ToDenom) GetErc20() string {
+	if m != nil {
+		return m.Erc20
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ERC20ToDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SendToEthereumPoolRecord records the block height a send to ethereum first
+// entered the pool at and the number of timed out batches it was part of, kept
+// until it leaves the bridge
+type SendToEthereumPoolRecord struct {
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height        uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	BatchTimeouts uint64 `protobuf:"varint,3,opt,name=batch_timeouts,json=batchTimeouts,proto3" json:"batch_timeouts,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnbatchedSendToEthereumTxs) > 0 {
-		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+func (m *SendToEthereumPoolRecord) Reset()         { *m = SendToEthereumPoolRecord{} }
+func (m *SendToEthereumPoolRecord) String() string { return proto.CompactTextString(m) }
+func (*SendToEthereumPoolRecord) ProtoMessage()    {}
+func (*SendToEthereumPoolRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+func (m *SendToEthereumPoolRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendToEthereumPoolRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendToEthereumPoolRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+}
+func (m *SendToEthereumPoolRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToEthereumPoolRecord.Merge(m, src)
+}
+func (m *SendToEthereumPoolRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendToEthereumPoolRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToEthereumPoolRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendToEthereumPoolRecord proto.InternalMessageInfo
+
+func (m *SendToEthereumPoolRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	return 0
+}
+
+func (m *SendToEthereumPoolRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.EthereumEventVoteRecords) > 0 {
-		for iNdEx := len(m.EthereumEventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EthereumEventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	return 0
+}
+
+func (m *SendToEthereumPoolRecord) GetBatchTimeouts() uint64 {
+	if m != nil {
+		return m.BatchTimeouts
 	}
-	if len(m.Confirmations) > 0 {
-		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return 0
+}
+
+// OutflowLimit caps the amount of a token that may leave for Ethereum within
+// a window of blocks
+type OutflowLimit struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Limit         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"limit"`
+	Window        uint64                                 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *OutflowLimit) Reset()         { *m = OutflowLimit{} }
+func (m *OutflowLimit) String() string { return proto.CompactTextString(m) }
+func (*OutflowLimit) ProtoMessage()    {}
+func (*OutflowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+func (m *OutflowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.OutgoingTxs) > 0 {
-		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+}
+func (m *OutflowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowLimit.Merge(m, src)
+}
+func (m *OutflowLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowLimit proto.InternalMessageInfo
+
+func (m *OutflowLimit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.LastObservedEventNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *OutflowLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return 0
+}
+
+// WindowAmount records the amount of a token counted against its limit at a
+// block height, kept while the height is within the window of the limit
+type WindowAmount struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Height        uint64                                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *WindowAmount) Reset()         { *m = WindowAmount{} }
+func (m *WindowAmount) String() string { return proto.CompactTextString(m) }
+func (*WindowAmount) ProtoMessage()    {}
+func (*WindowAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{5}
+}
+func (m *WindowAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *WindowAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowAmount.Merge(m, src)
+}
+func (m *WindowAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindowAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowAmount.DiscardUnknown(m)
 }
 
-func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WindowAmount proto.InternalMessageInfo
+
+func (m *WindowAmount) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WindowAmount) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+// OutflowPause records the block height until which new send to ethereums of
+// a token are paused after its outflow limit was reached
+type OutflowPause struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	PausedUntil   uint64 `protobuf:"varint,2,opt,name=paused_until,json=pausedUntil,proto3" json:"paused_until,omitempty"`
+}
+
+func (m *OutflowPause) Reset()         { *m = OutflowPause{} }
+func (m *OutflowPause) String() string { return proto.CompactTextString(m) }
+func (*OutflowPause) ProtoMessage()    {}
+func (*OutflowPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{6}
+}
+func (m *OutflowPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *OutflowPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowPause.Merge(m, src)
+}
+func (m *OutflowPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowPause proto.InternalMessageInfo
+
+func (m *OutflowPause) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OutflowPause) GetPausedUntil() uint64 {
+	if m != nil {
+		return m.PausedUntil
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+// BatchTrigger sets when batches of a token are created automatically. Zero
+// values disable a condition
+type BatchTrigger struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// the total fees the next batch has to pay
+	MinFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_fees,json=minFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fees"`
+	// the number of transfers of the token waiting in the pool
+	MinPoolSize uint64 `protobuf:"varint,3,opt,name=min_pool_size,json=minPoolSize,proto3" json:"min_pool_size,omitempty"`
+	// the number of blocks the oldest transfer of the token waited in the pool
+	MaxPoolAge uint64 `protobuf:"varint,4,opt,name=max_pool_age,json=maxPoolAge,proto3" json:"max_pool_age,omitempty"`
+	// the number of batches of the token waiting to be executed on ethereum
+	MaxOutstandingBatches uint64 `protobuf:"varint,5,opt,name=max_outstanding_batches,json=maxOutstandingBatches,proto3" json:"max_outstanding_batches,omitempty"`
+}
+
+func (m *BatchTrigger) Reset()         { *m = BatchTrigger{} }
+func (m *BatchTrigger) String() string { return proto.CompactTextString(m) }
+func (*BatchTrigger) ProtoMessage()    {}
+func (*BatchTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{7}
+}
+func (m *BatchTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.GravityId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *BatchTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTrigger.Merge(m, src)
+}
+func (m *BatchTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTrigger proto.InternalMessageInfo
+
+func (m *BatchTrigger) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	l = len(m.ContractSourceHash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *BatchTrigger) GetMinPoolSize() uint64 {
+	if m != nil {
+		return m.MinPoolSize
 	}
-	l = len(m.BridgeEthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return 0
+}
+
+func (m *BatchTrigger) GetMaxPoolAge() uint64 {
+	if m != nil {
+		return m.MaxPoolAge
 	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	return 0
+}
+
+func (m *BatchTrigger) GetMaxOutstandingBatches() uint64 {
+	if m != nil {
+		return m.MaxOutstandingBatches
 	}
-	if m.SignedSignerSetTxsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedSignerSetTxsWindow))
+	return 0
+}
+
+// BatchSettings are the batch_max_element, target_eth_tx_timeout and
+// batch_creation_period of a token
+type BatchSettings struct {
+	TokenContract       string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchMaxElement     uint64 `protobuf:"varint,2,opt,name=batch_max_element,json=batchMaxElement,proto3" json:"batch_max_element,omitempty"`
+	TargetEthTxTimeout  uint64 `protobuf:"varint,3,opt,name=target_eth_tx_timeout,json=targetEthTxTimeout,proto3" json:"target_eth_tx_timeout,omitempty"`
+	BatchCreationPeriod uint64 `protobuf:"varint,4,opt,name=batch_creation_period,json=batchCreationPeriod,proto3" json:"batch_creation_period,omitempty"`
+}
+
+func (m *BatchSettings) Reset()         { *m = BatchSettings{} }
+func (m *BatchSettings) String() string { return proto.CompactTextString(m) }
+func (*BatchSettings) ProtoMessage()    {}
+func (*BatchSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{8}
+}
+func (m *BatchSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SignedBatchesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+}
+func (m *BatchSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSettings.Merge(m, src)
+}
+func (m *BatchSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSettings proto.InternalMessageInfo
+
+func (m *BatchSettings) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.EthereumSignaturesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.EthereumSignaturesWindow))
+	return ""
+}
+
+func (m *BatchSettings) GetBatchMaxElement() uint64 {
+	if m != nil {
+		return m.BatchMaxElement
 	}
-	if m.TargetEthTxTimeout != 0 {
-		n += 1 + sovGenesis(uint64(m.TargetEthTxTimeout))
+	return 0
+}
+
+func (m *BatchSettings) GetTargetEthTxTimeout() uint64 {
+	if m != nil {
+		return m.TargetEthTxTimeout
 	}
-	if m.AverageBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	return 0
+}
+
+func (m *BatchSettings) GetBatchCreationPeriod() uint64 {
+	if m != nil {
+		return m.BatchCreationPeriod
 	}
-	if m.AverageEthereumBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	return 0
+}
+
+// ExecutedBatchStats summarizes a batch executed on ethereum
+type ExecutedBatchStats struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce    uint64 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	ElementCount  uint64 `protobuf:"varint,3,opt,name=element_count,json=elementCount,proto3" json:"element_count,omitempty"`
+	// the block height the batch was created at
+	CreatedHeight uint64 `protobuf:"varint,4,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// the block height its execution was observed at
+	ExecutedHeight uint64                                 `protobuf:"varint,5,opt,name=executed_height,json=executedHeight,proto3" json:"executed_height,omitempty"`
+	MinFee         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee"`
+	MedianFee      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=median_fee,json=medianFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"median_fee"`
+	MaxFee         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_fee"`
+}
+
+func (m *ExecutedBatchStats) Reset()         { *m = ExecutedBatchStats{} }
+func (m *ExecutedBatchStats) String() string { return proto.CompactTextString(m) }
+func (*ExecutedBatchStats) ProtoMessage()    {}
+func (*ExecutedBatchStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{9}
+}
+func (m *ExecutedBatchStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedBatchStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedBatchStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.SlashFractionSignerSetTx.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionBatch.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionEthereumSignature.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionConflictingEthereumSignature.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if m.UnbondSlashingSignerSetTxsWindow != 0 {
-		n += 2 + sovGenesis(uint64(m.UnbondSlashingSignerSetTxsWindow))
+}
+func (m *ExecutedBatchStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedBatchStats.Merge(m, src)
+}
+func (m *ExecutedBatchStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedBatchStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedBatchStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutedBatchStats proto.InternalMessageInfo
+
+func (m *ExecutedBatchStats) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.BridgeActive {
-		n += 3
+	return ""
+}
+
+func (m *ExecutedBatchStats) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
 	}
-	if m.BatchCreationPeriod != 0 {
-		n += 2 + sovGenesis(uint64(m.BatchCreationPeriod))
+	return 0
+}
+
+func (m *ExecutedBatchStats) GetElementCount() uint64 {
+	if m != nil {
+		return m.ElementCount
 	}
-	if m.BatchMaxElement != 0 {
-		n += 2 + sovGenesis(uint64(m.BatchMaxElement))
+	return 0
+}
+
+func (m *ExecutedBatchStats) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	if m.ObserveEthereumHeightPeriod != 0 {
-		n += 2 + sovGenesis(uint64(m.ObserveEthereumHeightPeriod))
+	return 0
+}
+
+func (m *ExecutedBatchStats) GetExecutedHeight() uint64 {
+	if m != nil {
+		return m.ExecutedHeight
 	}
-	return n
+	return 0
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+// InflowLimit caps the amount of a token that may be deposited from Ethereum
+// within a window of blocks
+type InflowLimit struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Limit         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"limit"`
+	Window        uint64                                 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *InflowLimit) Reset()         { *m = InflowLimit{} }
+func (m *InflowLimit) String() string { return proto.CompactTextString(m) }
+func (*InflowLimit) ProtoMessage()    {}
+func (*InflowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{10}
+}
+func (m *InflowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *InflowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflowLimit.Merge(m, src)
+}
+func (m *InflowLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflowLimit proto.InternalMessageInfo
+
+func (m *InflowLimit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.LastObservedEventNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	return ""
+}
+
+func (m *InflowLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	if len(m.OutgoingTxs) > 0 {
-		for _, e := range m.OutgoingTxs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return 0
+}
+
+// QuarantinedDeposit is a deposit from Ethereum that went over the inflow
+// limit of its token
+type QuarantinedDeposit struct {
+	Id      uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Deposit *SendToCosmosEvent `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Height  uint64             `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Status  QuarantineStatus   `protobuf:"varint,4,opt,name=status,proto3,enum=gravity.v1.QuarantineStatus" json:"status,omitempty"`
+	// the send to ethereum a returned deposit is sent back with
+	SendToEthereumId uint64 `protobuf:"varint,5,opt,name=send_to_ethereum_id,json=sendToEthereumId,proto3" json:"send_to_ethereum_id,omitempty"`
+}
+
+func (m *QuarantinedDeposit) Reset()         { *m = QuarantinedDeposit{} }
+func (m *QuarantinedDeposit) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDeposit) ProtoMessage()    {}
+func (*QuarantinedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{11}
+}
+func (m *QuarantinedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Confirmations) > 0 {
-		for _, e := range m.Confirmations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+}
+func (m *QuarantinedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDeposit.Merge(m, src)
+}
+func (m *QuarantinedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDeposit proto.InternalMessageInfo
+
+func (m *QuarantinedDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.EthereumEventVoteRecords) > 0 {
-		for _, e := range m.EthereumEventVoteRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return 0
+}
+
+func (m *QuarantinedDeposit) GetDeposit() *SendToCosmosEvent {
+	if m != nil {
+		return m.Deposit
 	}
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *QuarantinedDeposit) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for _, e := range m.Erc20ToDenoms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return 0
+}
+
+func (m *QuarantinedDeposit) GetStatus() QuarantineStatus {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.UnbatchedSendToEthereumTxs) > 0 {
-		for _, e := range m.UnbatchedSendToEthereumTxs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return QuarantineStatus_QUARANTINE_STATUS_UNSPECIFIED
+}
+
+func (m *QuarantinedDeposit) GetSendToEthereumId() uint64 {
+	if m != nil {
+		return m.SendToEthereumId
 	}
-	return n
+	return 0
 }
 
-func (m *ERC20ToDenom) Size() (n int) {
-	if m == nil {
-		return 0
+// SendToEthereumStatus tracks a SendToEthereum by id from the time it enters
+// the pool until it leaves the bridge
+type SendToEthereumStatus struct {
+	Id    uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	State SendToEthereumState `protobuf:"varint,2,opt,name=state,proto3,enum=gravity.v1.SendToEthereumState" json:"state,omitempty"`
+	// the batch the transfer is or was executed in
+	BatchNonce uint64 `protobuf:"varint,3,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	// the ethereum height the batch was executed at
+	EthereumHeight uint64 `protobuf:"varint,4,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// the number of timed out batches the transfer was part of
+	BatchTimeouts uint64 `protobuf:"varint,5,opt,name=batch_timeouts,json=batchTimeouts,proto3" json:"batch_timeouts,omitempty"`
+	RefundReason  string `protobuf:"bytes,6,opt,name=refund_reason,json=refundReason,proto3" json:"refund_reason,omitempty"`
+	// the block height of the last update
+	Height uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SendToEthereumStatus) Reset()         { *m = SendToEthereumStatus{} }
+func (m *SendToEthereumStatus) String() string { return proto.CompactTextString(m) }
+func (*SendToEthereumStatus) ProtoMessage()    {}
+func (*SendToEthereumStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{12}
+}
+func (m *SendToEthereumStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendToEthereumStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendToEthereumStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *SendToEthereumStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToEthereumStatus.Merge(m, src)
+}
+func (m *SendToEthereumStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendToEthereumStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToEthereumStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendToEthereumStatus proto.InternalMessageInfo
+
+func (m *SendToEthereumStatus) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SendToEthereumStatus) GetState() SendToEthereumState {
+	if m != nil {
+		return m.State
+	}
+	return SendToEthereumState_SEND_TO_ETHEREUM_STATE_UNSPECIFIED
+}
+
+func (m *SendToEthereumStatus) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *SendToEthereumStatus) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
+func (m *SendToEthereumStatus) GetBatchTimeouts() uint64 {
+	if m != nil {
+		return m.BatchTimeouts
+	}
+	return 0
+}
+
+func (m *SendToEthereumStatus) GetRefundReason() string {
+	if m != nil {
+		return m.RefundReason
+	}
+	return ""
+}
+
+func (m *SendToEthereumStatus) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// SignerSetHash is the hash of the signers of a signer set tx the module
+// created, kept after the signer set tx itself is pruned
+type SignerSetHash struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Hash  []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SignerSetHash) Reset()         { *m = SignerSetHash{} }
+func (m *SignerSetHash) String() string { return proto.CompactTextString(m) }
+func (*SignerSetHash) ProtoMessage()    {}
+func (*SignerSetHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{13}
+}
+func (m *SignerSetHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSetHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetHash.Merge(m, src)
+}
+func (m *SignerSetHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetHash proto.InternalMessageInfo
+
+func (m *SignerSetHash) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *SignerSetHash) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// SignerSetHijackIncident records an observed signer set update whose members
+// differ from the signer set tx the module created at that nonce
+type SignerSetHijackIncident struct {
+	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	// the hash of the signer set tx the module created, empty if it created none
+	ExpectedHash    []byte            `protobuf:"bytes,2,opt,name=expected_hash,json=expectedHash,proto3" json:"expected_hash,omitempty"`
+	ObservedHash    []byte            `protobuf:"bytes,3,opt,name=observed_hash,json=observedHash,proto3" json:"observed_hash,omitempty"`
+	ObservedSigners []*EthereumSigner `protobuf:"bytes,4,rep,name=observed_signers,json=observedSigners,proto3" json:"observed_signers,omitempty"`
+	EventNonce      uint64            `protobuf:"varint,5,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	// the block height the incident was recorded at
+	Height uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SignerSetHijackIncident) Reset()         { *m = SignerSetHijackIncident{} }
+func (m *SignerSetHijackIncident) String() string { return proto.CompactTextString(m) }
+func (*SignerSetHijackIncident) ProtoMessage()    {}
+func (*SignerSetHijackIncident) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{14}
+}
+func (m *SignerSetHijackIncident) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetHijackIncident) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetHijackIncident.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSetHijackIncident) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetHijackIncident.Merge(m, src)
+}
+func (m *SignerSetHijackIncident) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetHijackIncident) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetHijackIncident.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetHijackIncident proto.InternalMessageInfo
+
+func (m *SignerSetHijackIncident) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
+	}
+	return 0
+}
+
+func (m *SignerSetHijackIncident) GetExpectedHash() []byte {
+	if m != nil {
+		return m.ExpectedHash
+	}
+	return nil
+}
+
+func (m *SignerSetHijackIncident) GetObservedHash() []byte {
+	if m != nil {
+		return m.ObservedHash
+	}
+	return nil
+}
+
+func (m *SignerSetHijackIncident) GetObservedSigners() []*EthereumSigner {
+	if m != nil {
+		return m.ObservedSigners
+	}
+	return nil
+}
+
+func (m *SignerSetHijackIncident) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *SignerSetHijackIncident) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// BadSignatureEvidence records a validator slashed for signing over the
+// checkpoint of an outgoing tx the module never created
+type BadSignatureEvidence struct {
+	Checkpoint       []byte `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	EthereumSigner   string `protobuf:"bytes,2,opt,name=ethereum_signer,json=ethereumSigner,proto3" json:"ethereum_signer,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// the block height the evidence was submitted at
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *BadSignatureEvidence) Reset()         { *m = BadSignatureEvidence{} }
+func (m *BadSignatureEvidence) String() string { return proto.CompactTextString(m) }
+func (*BadSignatureEvidence) ProtoMessage()    {}
+func (*BadSignatureEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{15}
+}
+func (m *BadSignatureEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadSignatureEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadSignatureEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadSignatureEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadSignatureEvidence.Merge(m, src)
+}
+func (m *BadSignatureEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadSignatureEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadSignatureEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadSignatureEvidence proto.InternalMessageInfo
+
+func (m *BadSignatureEvidence) GetCheckpoint() []byte {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *BadSignatureEvidence) GetEthereumSigner() string {
+	if m != nil {
+		return m.EthereumSigner
+	}
+	return ""
+}
+
+func (m *BadSignatureEvidence) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *BadSignatureEvidence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// BadSignatureEvidenceFloor holds the latest nonces issued before the module
+// kept the checkpoints of its outgoing txs. Signatures over signer sets and
+// batches at or below them cannot be told apart from legitimate ones. Contract
+// calls share no nonce, so their floor is the latest invalidation nonce of each
+// invalidation scope that still had contract calls.
+type BadSignatureEvidenceFloor struct {
+	SignerSetNonce     uint64                    `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	BatchNonce         uint64                    `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	ContractCallScopes []*ContractCallScopeFloor `protobuf:"bytes,3,rep,name=contract_call_scopes,json=contractCallScopes,proto3" json:"contract_call_scopes,omitempty"`
+}
+
+func (m *BadSignatureEvidenceFloor) Reset()         { *m = BadSignatureEvidenceFloor{} }
+func (m *BadSignatureEvidenceFloor) String() string { return proto.CompactTextString(m) }
+func (*BadSignatureEvidenceFloor) ProtoMessage()    {}
+func (*BadSignatureEvidenceFloor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{16}
+}
+func (m *BadSignatureEvidenceFloor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadSignatureEvidenceFloor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadSignatureEvidenceFloor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadSignatureEvidenceFloor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadSignatureEvidenceFloor.Merge(m, src)
+}
+func (m *BadSignatureEvidenceFloor) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadSignatureEvidenceFloor) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadSignatureEvidenceFloor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadSignatureEvidenceFloor proto.InternalMessageInfo
+
+func (m *BadSignatureEvidenceFloor) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
+	}
+	return 0
+}
+
+func (m *BadSignatureEvidenceFloor) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *BadSignatureEvidenceFloor) GetContractCallScopes() []*ContractCallScopeFloor {
+	if m != nil {
+		return m.ContractCallScopes
+	}
+	return nil
+}
+
+// ContractCallScopeFloor holds the latest invalidation nonce of an invalidation
+// scope issued before the module kept the checkpoints of its outgoing txs
+type ContractCallScopeFloor struct {
+	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+}
+
+func (m *ContractCallScopeFloor) Reset()         { *m = ContractCallScopeFloor{} }
+func (m *ContractCallScopeFloor) String() string { return proto.CompactTextString(m) }
+func (*ContractCallScopeFloor) ProtoMessage()    {}
+func (*ContractCallScopeFloor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{17}
+}
+func (m *ContractCallScopeFloor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallScopeFloor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallScopeFloor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCallScopeFloor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallScopeFloor.Merge(m, src)
+}
+func (m *ContractCallScopeFloor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallScopeFloor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallScopeFloor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCallScopeFloor proto.InternalMessageInfo
+
+func (m *ContractCallScopeFloor) GetInvalidationScope() []byte {
+	if m != nil {
+		return m.InvalidationScope
+	}
+	return nil
+}
+
+func (m *ContractCallScopeFloor) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
+	}
+	return 0
+}
+
+// ConflictingEventVote records a validator vote for another event than the
+// accepted one at an event nonce, counted against its grace votes
+type ConflictingEventVote struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EventNonce       uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	// the block height the conflicting vote was tallied at
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ConflictingEventVote) Reset()         { *m = ConflictingEventVote{} }
+func (m *ConflictingEventVote) String() string { return proto.CompactTextString(m) }
+func (*ConflictingEventVote) ProtoMessage()    {}
+func (*ConflictingEventVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{18}
+}
+func (m *ConflictingEventVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingEventVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingEventVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictingEventVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingEventVote.Merge(m, src)
+}
+func (m *ConflictingEventVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingEventVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingEventVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictingEventVote proto.InternalMessageInfo
+
+func (m *ConflictingEventVote) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ConflictingEventVote) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *ConflictingEventVote) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EthereumEventAcceptedHeight records the block height an event nonce was
+// accepted at, kept until every bonded validator voted on it
+type EthereumEventAcceptedHeight struct {
+	EventNonce uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Height     uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EthereumEventAcceptedHeight) Reset()         { *m = EthereumEventAcceptedHeight{} }
+func (m *EthereumEventAcceptedHeight) String() string { return proto.CompactTextString(m) }
+func (*EthereumEventAcceptedHeight) ProtoMessage()    {}
+func (*EthereumEventAcceptedHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{19}
+}
+func (m *EthereumEventAcceptedHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumEventAcceptedHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumEventAcceptedHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumEventAcceptedHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumEventAcceptedHeight.Merge(m, src)
+}
+func (m *EthereumEventAcceptedHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumEventAcceptedHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumEventAcceptedHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumEventAcceptedHeight proto.InternalMessageInfo
+
+func (m *EthereumEventAcceptedHeight) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *EthereumEventAcceptedHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EthereumEventExcusedNonce records the last observed event nonce when a
+// validator bonded or was penalised for missing event votes. Missing the
+// events up to it is not held against the validator.
+type EthereumEventExcusedNonce struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EventNonce       uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *EthereumEventExcusedNonce) Reset()         { *m = EthereumEventExcusedNonce{} }
+func (m *EthereumEventExcusedNonce) String() string { return proto.CompactTextString(m) }
+func (*EthereumEventExcusedNonce) ProtoMessage()    {}
+func (*EthereumEventExcusedNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{20}
+}
+func (m *EthereumEventExcusedNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumEventExcusedNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumEventExcusedNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumEventExcusedNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumEventExcusedNonce.Merge(m, src)
+}
+func (m *EthereumEventExcusedNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumEventExcusedNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumEventExcusedNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumEventExcusedNonce proto.InternalMessageInfo
+
+func (m *EthereumEventExcusedNonce) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EthereumEventExcusedNonce) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+// BridgeSigningInfo counts the outgoing txs a validator missed signing within
+// the last bridge_signing_window outgoing txs it was expected to sign, like
+// the x/slashing ValidatorSigningInfo counts missed blocks
+type BridgeSigningInfo struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// the block height the validator was first expected to sign an outgoing tx at
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// number of outgoing txs the validator was expected to sign since it started
+	// or was last penalised
+	IndexOffset           uint64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	MissedSignerSetTxs    uint64 `protobuf:"varint,4,opt,name=missed_signer_set_txs,json=missedSignerSetTxs,proto3" json:"missed_signer_set_txs,omitempty"`
+	MissedBatchTxs        uint64 `protobuf:"varint,5,opt,name=missed_batch_txs,json=missedBatchTxs,proto3" json:"missed_batch_txs,omitempty"`
+	MissedContractCallTxs uint64 `protobuf:"varint,6,opt,name=missed_contract_call_txs,json=missedContractCallTxs,proto3" json:"missed_contract_call_txs,omitempty"`
+	// the type of the outgoing tx missed at each index of the window, or
+	// unspecified if it was signed
+	MissedOutgoingTxs []OutgoingTxType `protobuf:"varint,7,rep,packed,name=missed_outgoing_txs,json=missedOutgoingTxs,proto3,enum=gravity.v1.OutgoingTxType" json:"missed_outgoing_txs,omitempty"`
+}
+
+func (m *BridgeSigningInfo) Reset()         { *m = BridgeSigningInfo{} }
+func (m *BridgeSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*BridgeSigningInfo) ProtoMessage()    {}
+func (*BridgeSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{21}
+}
+func (m *BridgeSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeSigningInfo.Merge(m, src)
+}
+func (m *BridgeSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeSigningInfo proto.InternalMessageInfo
+
+func (m *BridgeSigningInfo) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *BridgeSigningInfo) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *BridgeSigningInfo) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *BridgeSigningInfo) GetMissedSignerSetTxs() uint64 {
+	if m != nil {
+		return m.MissedSignerSetTxs
+	}
+	return 0
+}
+
+func (m *BridgeSigningInfo) GetMissedBatchTxs() uint64 {
+	if m != nil {
+		return m.MissedBatchTxs
+	}
+	return 0
+}
+
+func (m *BridgeSigningInfo) GetMissedContractCallTxs() uint64 {
+	if m != nil {
+		return m.MissedContractCallTxs
+	}
+	return 0
+}
+
+func (m *BridgeSigningInfo) GetMissedOutgoingTxs() []OutgoingTxType {
+	if m != nil {
+		return m.MissedOutgoingTxs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("gravity.v1.BatchSelectionStrategy", BatchSelectionStrategy_name, BatchSelectionStrategy_value)
+	proto.RegisterEnum("gravity.v1.EthereumEventVoteSlashingMode", EthereumEventVoteSlashingMode_name, EthereumEventVoteSlashingMode_value)
+	proto.RegisterEnum("gravity.v1.QuarantineStatus", QuarantineStatus_name, QuarantineStatus_value)
+	proto.RegisterEnum("gravity.v1.SendToEthereumState", SendToEthereumState_name, SendToEthereumState_value)
+	proto.RegisterEnum("gravity.v1.OutgoingTxType", OutgoingTxType_name, OutgoingTxType_value)
+	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+	proto.RegisterType((*SendToEthereumPoolRecord)(nil), "gravity.v1.SendToEthereumPoolRecord")
+	proto.RegisterType((*OutflowLimit)(nil), "gravity.v1.OutflowLimit")
+	proto.RegisterType((*WindowAmount)(nil), "gravity.v1.WindowAmount")
+	proto.RegisterType((*OutflowPause)(nil), "gravity.v1.OutflowPause")
+	proto.RegisterType((*BatchTrigger)(nil), "gravity.v1.BatchTrigger")
+	proto.RegisterType((*BatchSettings)(nil), "gravity.v1.BatchSettings")
+	proto.RegisterType((*ExecutedBatchStats)(nil), "gravity.v1.ExecutedBatchStats")
+	proto.RegisterType((*InflowLimit)(nil), "gravity.v1.InflowLimit")
+	proto.RegisterType((*QuarantinedDeposit)(nil), "gravity.v1.QuarantinedDeposit")
+	proto.RegisterType((*SendToEthereumStatus)(nil), "gravity.v1.SendToEthereumStatus")
+	proto.RegisterType((*SignerSetHash)(nil), "gravity.v1.SignerSetHash")
+	proto.RegisterType((*SignerSetHijackIncident)(nil), "gravity.v1.SignerSetHijackIncident")
+	proto.RegisterType((*BadSignatureEvidence)(nil), "gravity.v1.BadSignatureEvidence")
+	proto.RegisterType((*BadSignatureEvidenceFloor)(nil), "gravity.v1.BadSignatureEvidenceFloor")
+	proto.RegisterType((*ContractCallScopeFloor)(nil), "gravity.v1.ContractCallScopeFloor")
+	proto.RegisterType((*ConflictingEventVote)(nil), "gravity.v1.ConflictingEventVote")
+	proto.RegisterType((*EthereumEventAcceptedHeight)(nil), "gravity.v1.EthereumEventAcceptedHeight")
+	proto.RegisterType((*EthereumEventExcusedNonce)(nil), "gravity.v1.EthereumEventExcusedNonce")
+	proto.RegisterType((*BridgeSigningInfo)(nil), "gravity.v1.BridgeSigningInfo")
+}
+
+func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+
+var fileDescriptor_387b0aba880adb60 = []byte{
+	// 3375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0xdb, 0xc6,
+	0x15, 0x37, 0x45, 0x59, 0xb2, 0x9f, 0x28, 0x89, 0x5a, 0xcb, 0x12, 0xf4, 0x45, 0x51, 0x94, 0x3f,
+	0x14, 0xb9, 0x96, 0x62, 0x35, 0x4d, 0x26, 0x49, 0xdb, 0x84, 0x22, 0x21, 0x89, 0x89, 0x24, 0x2a,
+	0x20, 0xe4, 0xda, 0x6d, 0xa6, 0x28, 0x08, 0x2c, 0x49, 0xc4, 0x24, 0xa0, 0x60, 0x41, 0x99, 0xca,
+	0xf4, 0x90, 0x7b, 0xa7, 0x33, 0x99, 0x66, 0x3a, 0xd3, 0x3f, 0xa0, 0x7f, 0x41, 0x7b, 0xed, 0xad,
+	0x97, 0xb4, 0xa7, 0x1c, 0x3b, 0x69, 0x27, 0xd3, 0x49, 0x2e, 0xbd, 0xf7, 0xde, 0xe9, 0xec, 0x07,
+	0x40, 0x80, 0x00, 0x65, 0x47, 0xd3, 0x43, 0x4f, 0x16, 0xde, 0xfb, 0xbd, 0xb7, 0x6f, 0xdf, 0xee,
+	0xbe, 0x2f, 0x1a, 0xa4, 0xa6, 0xab, 0x9f, 0x5b, 0xde, 0xc5, 0xf6, 0xf9, 0xa3, 0xed, 0x26, 0xb6,
+	0x31, 0xb1, 0xc8, 0xd6, 0x99, 0xeb, 0x78, 0x0e, 0x02, 0xc1, 0xd9, 0x3a, 0x7f, 0xb4, 0x38, 0xdb,
+	0x74, 0x9a, 0x0e, 0x23, 0x6f, 0xd3, 0xbf, 0x38, 0x62, 0x71, 0xa1, 0xe9, 0x38, 0xcd, 0x36, 0xde,
+	0x66, 0x5f, 0xf5, 0x6e, 0x63, 0x5b, 0xb7, 0x2f, 0x04, 0x2b, 0xa2, 0x56, 0xe8, 0xe1, 0x9c, 0xdb,
+	0x21, 0x4e, 0x87, 0x34, 0xc5, 0x6a, 0x85, 0x3f, 0x4b, 0x30, 0x76, 0xa2, 0xbb, 0x7a, 0x87, 0xa0,
+	0x15, 0xf0, 0x97, 0xd6, 0x2c, 0x53, 0x4a, 0xe5, 0x53, 0x1b, 0x37, 0x95, 0x9b, 0x82, 0x52, 0x31,
+	0xd1, 0xab, 0x30, 0x6b, 0x38, 0xb6, 0xe7, 0xea, 0x86, 0xa7, 0x11, 0xa7, 0xeb, 0x1a, 0x58, 0x6b,
+	0xe9, 0xa4, 0x25, 0x8d, 0x30, 0x20, 0xf2, 0x79, 0x35, 0xc6, 0x3a, 0xd0, 0x49, 0x0b, 0xbd, 0x0e,
+	0xf3, 0x75, 0xd7, 0x32, 0x9b, 0x58, 0xc3, 0x5e, 0x0b, 0xbb, 0xb8, 0xdb, 0xd1, 0x74, 0xd3, 0x74,
+	0x31, 0x21, 0xd2, 0x28, 0x13, 0xba, 0xcd, 0xd9, 0xb2, 0xe0, 0x16, 0x39, 0x13, 0xdd, 0x83, 0x69,
+	0x21, 0x67, 0xb4, 0x74, 0xcb, 0xa6, 0xd6, 0x5c, 0xcf, 0xa7, 0x36, 0x46, 0x95, 0x49, 0x4e, 0x2e,
+	0x51, 0x6a, 0xc5, 0x44, 0x3f, 0x86, 0x65, 0x62, 0x35, 0x6d, 0x6c, 0x6a, 0xec, 0x1f, 0x57, 0x23,
+	0xd8, 0xd3, 0xbc, 0x1e, 0xd1, 0x9e, 0x5b, 0xb6, 0xe9, 0x3c, 0x97, 0xc6, 0x98, 0x90, 0xc4, 0x31,
+	0x35, 0x06, 0xa9, 0x61, 0x4f, 0xed, 0x91, 0x9f, 0x30, 0x3e, 0xda, 0x81, 0xdb, 0x42, 0xbe, 0xae,
+	0x7b, 0x46, 0x0b, 0x07, 0x82, 0xe3, 0x4c, 0xf0, 0x16, 0x67, 0xee, 0x72, 0x9e, 0x90, 0xf9, 0x21,
+	0x2c, 0x06, 0x9b, 0xa1, 0x7c, 0xdd, 0xeb, 0xba, 0x7d, 0xc1, 0x1b, 0x7c, 0x45, 0x1f, 0x51, 0x0b,
+	0x00, 0x42, 0xfa, 0x11, 0xdc, 0xf6, 0x74, 0xb7, 0x89, 0x3d, 0xea, 0x11, 0xcd, 0xeb, 0x69, 0x9e,
+	0xd5, 0xc1, 0x4e, 0xd7, 0x93, 0x80, 0x09, 0x22, 0xce, 0x94, 0xbd, 0x96, 0xda, 0x53, 0x39, 0x07,
+	0x7d, 0x0f, 0x90, 0x7e, 0x8e, 0x5d, 0xbd, 0x89, 0xb5, 0x7a, 0xdb, 0x31, 0x9e, 0x31, 0x11, 0x69,
+	0x82, 0xe1, 0xb3, 0x82, 0xb3, 0x4b, 0x19, 0x54, 0x00, 0xfd, 0x08, 0x96, 0x7c, 0x74, 0x60, 0x66,
+	0x48, 0x2c, 0xc3, 0xed, 0x13, 0x10, 0xdf, 0xef, 0x7d, 0x71, 0x1b, 0x96, 0x49, 0x5b, 0x27, 0x2d,
+	0xad, 0x41, 0x8f, 0xd2, 0x72, 0xec, 0xa8, 0x67, 0xa5, 0xc9, 0x7c, 0x6a, 0x23, 0xb3, 0xbb, 0xf5,
+	0xc5, 0xd7, 0xab, 0xd7, 0xbe, 0xfa, 0x7a, 0xf5, 0x5e, 0xd3, 0xf2, 0x5a, 0xdd, 0xfa, 0x96, 0xe1,
+	0x74, 0xb6, 0x0d, 0x87, 0x74, 0x1c, 0x22, 0xfe, 0x79, 0x48, 0xcc, 0x67, 0xdb, 0xde, 0xc5, 0x19,
+	0x26, 0x5b, 0x65, 0x6c, 0x28, 0x12, 0xd3, 0xb9, 0x27, 0x54, 0x86, 0x0e, 0x02, 0xfd, 0x02, 0x66,
+	0x07, 0xd6, 0x63, 0x27, 0x21, 0x4d, 0x5d, 0x69, 0x1d, 0x14, 0x59, 0x87, 0x9d, 0x1b, 0xba, 0x80,
+	0xb5, 0x81, 0x15, 0xe2, 0xc7, 0x27, 0x4d, 0x5f, 0x69, 0xb9, 0x5c, 0x64, 0x39, 0x79, 0xf0, 0xcc,
+	0xd1, 0x67, 0x29, 0x78, 0x38, 0xb0, 0xb6, 0xe1, 0xd8, 0x8d, 0xb6, 0x65, 0x78, 0x96, 0xdd, 0x4c,
+	0xb2, 0x23, 0x7b, 0x25, 0x3b, 0x5e, 0x89, 0xd8, 0x51, 0xea, 0x2f, 0x11, 0x37, 0xa9, 0x0a, 0x77,
+	0xbb, 0x76, 0xdd, 0xb1, 0x4d, 0x8d, 0xc9, 0x50, 0x33, 0x92, 0x9f, 0xce, 0x0c, 0xbb, 0x28, 0x79,
+	0x0e, 0xae, 0x09, 0x6c, 0xc2, 0x13, 0x5a, 0x07, 0xf1, 0x26, 0x35, 0xba, 0xfa, 0x39, 0x96, 0x50,
+	0x3e, 0xb5, 0x71, 0x43, 0xc9, 0x70, 0x62, 0x91, 0xd1, 0xe8, 0x3b, 0x63, 0xc7, 0xaa, 0x19, 0x2e,
+	0xd6, 0x99, 0x1f, 0xce, 0xb0, 0x6b, 0x39, 0xa6, 0x74, 0x8b, 0xbf, 0x33, 0xc6, 0x2c, 0x09, 0xde,
+	0x09, 0x63, 0xa1, 0x4d, 0x98, 0xe1, 0x32, 0x1d, 0xbd, 0xa7, 0xe1, 0x36, 0xee, 0x60, 0xdb, 0x93,
+	0x66, 0x19, 0x7e, 0x9a, 0x31, 0x8e, 0xf4, 0x9e, 0xcc, 0xc9, 0xa8, 0x04, 0x39, 0xa7, 0x4e, 0xb0,
+	0x7b, 0x1e, 0xba, 0xf4, 0x2d, 0x6c, 0x35, 0x5b, 0x9e, 0xbf, 0xd0, 0x6d, 0x26, 0xb8, 0x24, 0x50,
+	0xbe, 0x5f, 0x0e, 0x18, 0x46, 0x2c, 0xf8, 0x0e, 0xac, 0x10, 0x6c, 0x9b, 0x9a, 0xe7, 0xf4, 0x95,
+	0xd0, 0xb5, 0xcf, 0x1c, 0xa7, 0xad, 0xe9, 0x4d, 0x2c, 0xcd, 0x89, 0x68, 0x82, 0x6d, 0x53, 0x75,
+	0x7c, 0x15, 0x47, 0x7a, 0xef, 0xc4, 0x71, 0xda, 0xc5, 0x26, 0x46, 0xef, 0xc3, 0x7a, 0xa2, 0x02,
+	0xbe, 0x0d, 0xf1, 0xd0, 0x89, 0x34, 0xcf, 0xd4, 0xe4, 0x62, 0x6a, 0xd8, 0x75, 0x15, 0x8f, 0x9e,
+	0xa0, 0x32, 0x4c, 0x77, 0x2c, 0x5b, 0x13, 0xbe, 0x6d, 0x60, 0x4c, 0x24, 0x29, 0x9f, 0xde, 0x98,
+	0xd8, 0x99, 0xdb, 0xea, 0xa7, 0x87, 0x2d, 0x59, 0x29, 0xed, 0xbc, 0xaa, 0x3a, 0xcf, 0xb0, 0xbd,
+	0x3b, 0x4a, 0x2f, 0x8d, 0x32, 0xd9, 0xb1, 0xec, 0x5d, 0x26, 0xb3, 0x87, 0x31, 0x41, 0x32, 0x4c,
+	0x39, 0x5d, 0xaf, 0xd1, 0x76, 0x9e, 0x6b, 0x6d, 0xab, 0x63, 0x79, 0x44, 0x5a, 0x60, 0x4a, 0xa4,
+	0xb0, 0x92, 0x2a, 0x47, 0x1c, 0x52, 0x80, 0xaf, 0xc6, 0x09, 0xd1, 0x08, 0xda, 0x85, 0x49, 0xcb,
+	0x0e, 0x6b, 0x59, 0x64, 0x5a, 0xe6, 0xc3, 0x5a, 0x2a, 0xf6, 0xa0, 0x92, 0x8c, 0x65, 0x87, 0x74,
+	0x1c, 0xc0, 0x5a, 0xcc, 0x3b, 0xc4, 0xd3, 0xbd, 0x2e, 0xd1, 0x5c, 0xec, 0x61, 0x9b, 0x1e, 0xbd,
+	0xb4, 0xc4, 0x7c, 0xb3, 0x12, 0xf5, 0x4d, 0x8d, 0xa1, 0x14, 0x1f, 0x84, 0x3e, 0x04, 0x89, 0xbb,
+	0x94, 0xe0, 0x36, 0x16, 0x41, 0xca, 0x73, 0x75, 0x0f, 0x37, 0x2f, 0xa4, 0xe5, 0x7c, 0x6a, 0x63,
+	0x6a, 0xa7, 0x10, 0x36, 0x8c, 0xf9, 0xb5, 0xe6, 0x43, 0x6b, 0x02, 0xa9, 0xcc, 0xd5, 0x13, 0xe9,
+	0xe8, 0x43, 0x40, 0x5c, 0xbb, 0xd3, 0x36, 0x31, 0xf1, 0x34, 0xd2, 0xd2, 0x5d, 0x2c, 0xad, 0x5c,
+	0xe9, 0x61, 0x66, 0x99, 0xa6, 0x2a, 0x53, 0x54, 0xa3, 0x7a, 0xe8, 0x81, 0x88, 0xeb, 0xe0, 0x5a,
+	0xcd, 0x26, 0x76, 0x89, 0x94, 0x8b, 0x1f, 0x08, 0xbf, 0x09, 0x1c, 0xe0, 0x1f, 0x48, 0x3d, 0x44,
+	0x23, 0xe8, 0x69, 0xdf, 0x05, 0x1e, 0x7d, 0xe8, 0x44, 0x73, 0xce, 0xb1, 0xeb, 0x5a, 0x26, 0x26,
+	0xd2, 0x2a, 0x53, 0xb8, 0x90, 0xe0, 0x02, 0x0e, 0x15, 0x1a, 0xe7, 0xea, 0x61, 0x62, 0xd5, 0x17,
+	0xa7, 0x09, 0x04, 0xf7, 0xb0, 0xd1, 0xf5, 0xfc, 0xac, 0xc8, 0x4e, 0x29, 0x88, 0x0b, 0x79, 0x91,
+	0xe0, 0x04, 0x84, 0x6b, 0xa6, 0x00, 0x11, 0x0f, 0x3c, 0x58, 0x0d, 0x05, 0x94, 0x33, 0xe7, 0x39,
+	0x76, 0x35, 0xd3, 0x6a, 0x34, 0x34, 0xaf, 0xe5, 0x62, 0xd2, 0x72, 0xda, 0xa6, 0xb4, 0x76, 0x25,
+	0x5f, 0x2e, 0x11, 0x3f, 0xf8, 0x9c, 0x50, 0xa5, 0x65, 0xab, 0xd1, 0x50, 0x7d, 0x95, 0xe8, 0x01,
+	0xa0, 0xd0, 0xaa, 0xf4, 0xd1, 0xd1, 0x07, 0x5b, 0xe0, 0xd1, 0x22, 0x10, 0x3c, 0xd2, 0x7b, 0xf4,
+	0x9d, 0x7e, 0x9a, 0x82, 0xbb, 0xb1, 0xa4, 0x63, 0x26, 0x85, 0xe3, 0xf5, 0x2b, 0x59, 0xba, 0x36,
+	0x90, 0x85, 0xcc, 0x78, 0x18, 0x3e, 0x82, 0xf5, 0xc4, 0x4c, 0x80, 0xcf, 0xb1, 0xed, 0x05, 0xa1,
+	0x59, 0xba, 0xc3, 0x62, 0x69, 0xde, 0x88, 0x47, 0x74, 0x99, 0x02, 0xfd, 0xb0, 0x8c, 0x14, 0xb8,
+	0x77, 0x89, 0xba, 0xa6, 0xab, 0x1b, 0x58, 0x3b, 0x77, 0x3c, 0x4c, 0xa4, 0xbb, 0xcc, 0x25, 0x85,
+	0x61, 0x1a, 0xf7, 0x29, 0xf4, 0x31, 0x45, 0x22, 0x15, 0xee, 0xbf, 0x50, 0xa7, 0xb8, 0x13, 0xf7,
+	0x98, 0xd2, 0xf5, 0x4b, 0x95, 0x8a, 0xeb, 0x41, 0x60, 0x6d, 0x40, 0x13, 0xb5, 0xab, 0x9f, 0x8c,
+	0x3a, 0x8e, 0x89, 0xa5, 0xfb, 0xec, 0x11, 0xbf, 0x12, 0x09, 0x74, 0x61, 0x85, 0xd4, 0x40, 0x7f,
+	0xef, 0x47, 0x8e, 0x89, 0x95, 0x15, 0x7c, 0x19, 0x9b, 0xdd, 0xc9, 0x58, 0x1a, 0xe6, 0x75, 0xac,
+	0xa1, 0xb7, 0xdb, 0xb4, 0xae, 0xd9, 0xb8, 0xe2, 0x9d, 0x1c, 0x48, 0xbc, 0x4c, 0x69, 0x49, 0x6f,
+	0xb7, 0xd5, 0x1e, 0x4b, 0x7a, 0x3c, 0x7a, 0xd3, 0x0b, 0x45, 0x37, 0x27, 0xdc, 0xf5, 0x8a, 0x48,
+	0x7a, 0x8c, 0x59, 0xe3, 0xbc, 0xfe, 0xeb, 0x19, 0x90, 0xa1, 0x77, 0xb9, 0x63, 0x11, 0x82, 0x4d,
+	0xcd, 0xa5, 0xe9, 0x51, 0xda, 0xbc, 0x9a, 0xa5, 0x91, 0xd5, 0x8e, 0xf4, 0xde, 0x11, 0xd3, 0xa9,
+	0x50, 0x95, 0xe8, 0x6d, 0x58, 0x74, 0x5c, 0xdd, 0x68, 0x63, 0xad, 0xad, 0x37, 0xc5, 0xb1, 0xf4,
+	0x9f, 0xeb, 0x03, 0x66, 0xee, 0x3c, 0x47, 0x1c, 0xea, 0x4d, 0xe6, 0xe3, 0xe0, 0xe9, 0xbd, 0x35,
+	0xfa, 0xe9, 0x3f, 0xf2, 0xd7, 0x0a, 0x7f, 0x9d, 0x81, 0xcc, 0x3e, 0xef, 0x62, 0x68, 0x34, 0xc0,
+	0x68, 0x13, 0xc6, 0xce, 0x58, 0x57, 0xc1, 0xfa, 0x88, 0x89, 0x1d, 0x14, 0x3e, 0x4d, 0xde, 0x6f,
+	0x28, 0x02, 0x81, 0xde, 0x84, 0x85, 0xb6, 0x4e, 0x3c, 0x4d, 0x64, 0x67, 0x53, 0x98, 0x60, 0x3b,
+	0xb6, 0x81, 0x59, 0x77, 0x31, 0xaa, 0xcc, 0x51, 0x40, 0x55, 0xf0, 0x99, 0x05, 0xc7, 0x94, 0x8b,
+	0xde, 0x80, 0x8c, 0xd3, 0xf5, 0x9a, 0x0e, 0x75, 0x95, 0xd7, 0x23, 0x52, 0x9a, 0x05, 0xbf, 0xd9,
+	0x2d, 0xde, 0x20, 0x6d, 0xf9, 0x0d, 0xd2, 0x56, 0xd1, 0xbe, 0x50, 0x26, 0x7c, 0xa4, 0xda, 0x23,
+	0xe8, 0x2d, 0x98, 0xa4, 0xf7, 0xd5, 0x72, 0x3b, 0xac, 0xe8, 0xa0, 0x0d, 0xc9, 0x70, 0xc9, 0x28,
+	0x14, 0xd5, 0x61, 0x29, 0xe9, 0x12, 0xbb, 0xd8, 0x70, 0x5c, 0x93, 0x48, 0x37, 0x99, 0xa6, 0xf5,
+	0x4b, 0xaf, 0xaf, 0xc2, 0xb0, 0xfd, 0x46, 0x61, 0x80, 0x41, 0xd0, 0xbb, 0x30, 0x69, 0xe2, 0x36,
+	0x6e, 0xea, 0x1e, 0xd6, 0x9e, 0xe1, 0x0b, 0x22, 0x01, 0xd3, 0xba, 0x14, 0xd6, 0x7a, 0x44, 0x9a,
+	0x65, 0x81, 0x79, 0x1f, 0x5f, 0x10, 0x25, 0x63, 0x86, 0xbe, 0xd0, 0xbb, 0x30, 0x8d, 0x5d, 0x63,
+	0xe7, 0x55, 0x9a, 0x71, 0x4d, 0x6c, 0x3b, 0x1d, 0x22, 0x4d, 0xc4, 0x73, 0x8d, 0xa8, 0x20, 0xca,
+	0x14, 0xa0, 0x4c, 0x32, 0x01, 0xf1, 0x45, 0xd0, 0xcf, 0x21, 0xd7, 0xb5, 0x79, 0x67, 0x64, 0x6a,
+	0xb1, 0xe4, 0x4d, 0xdd, 0x9d, 0x61, 0x0a, 0x17, 0xc3, 0x0a, 0x6b, 0x91, 0xdc, 0xad, 0x2c, 0x06,
+	0x1a, 0xa2, 0x0c, 0x7a, 0x06, 0x1f, 0xc0, 0xec, 0xc7, 0x5d, 0xdd, 0xd5, 0x6d, 0xcf, 0xa2, 0x3d,
+	0x98, 0x89, 0xcf, 0x1c, 0x42, 0xab, 0x8b, 0x49, 0xa6, 0x35, 0x17, 0xd6, 0xfa, 0x41, 0x1f, 0x57,
+	0xe6, 0x30, 0xe5, 0xd6, 0xc7, 0x31, 0x1a, 0x41, 0x0f, 0x60, 0x26, 0x30, 0xd0, 0xc4, 0xf6, 0x45,
+	0xdb, 0x22, 0x9e, 0x34, 0x95, 0x4f, 0x6f, 0xdc, 0x54, 0xb2, 0x3e, 0xa3, 0x2c, 0xe8, 0xe8, 0x67,
+	0xb0, 0x30, 0xa4, 0x24, 0xc1, 0x44, 0x9a, 0x66, 0x46, 0xe4, 0x87, 0x6f, 0x4d, 0x94, 0x25, 0x73,
+	0x49, 0xc5, 0x0a, 0x26, 0xe8, 0x04, 0x66, 0x93, 0xf2, 0xa8, 0x94, 0x8d, 0x6f, 0x4e, 0x8e, 0x25,
+	0x53, 0x05, 0xc5, 0x13, 0x2c, 0x92, 0x61, 0x26, 0x94, 0xe4, 0x68, 0xeb, 0x8d, 0x89, 0x34, 0x13,
+	0xcf, 0xf6, 0x41, 0x95, 0x4e, 0x7b, 0xf0, 0x50, 0xfa, 0x3b, 0x60, 0x12, 0xf4, 0xf6, 0x86, 0xd5,
+	0x58, 0x1f, 0xe9, 0xc6, 0x33, 0xcd, 0xb2, 0x0d, 0xcb, 0xc4, 0xb6, 0x47, 0x24, 0x14, 0xbf, 0xbd,
+	0x7d, 0x85, 0x0c, 0x5c, 0x11, 0x58, 0xd1, 0x58, 0xc7, 0x19, 0xb4, 0x7a, 0xcd, 0xc5, 0xd3, 0xa9,
+	0x66, 0xb4, 0xb0, 0xf1, 0xec, 0xcc, 0xb1, 0xe8, 0x32, 0xb7, 0xf2, 0xe9, 0x8d, 0x8c, 0xb2, 0x1c,
+	0x6b, 0x94, 0x4b, 0x7d, 0x0c, 0x7a, 0x0c, 0x73, 0x34, 0x31, 0xf7, 0x15, 0xe0, 0x73, 0xaa, 0xdf,
+	0xc0, 0xd2, 0x6c, 0xfc, 0x70, 0x76, 0x75, 0x33, 0x50, 0x22, 0x0b, 0x9c, 0x32, 0x5b, 0x4f, 0xa0,
+	0xa2, 0x06, 0x2c, 0x27, 0xeb, 0xd5, 0x1a, 0x6d, 0xc7, 0x71, 0x59, 0xb3, 0x30, 0xb1, 0x73, 0xf7,
+	0x45, 0xda, 0xf7, 0x28, 0x58, 0x59, 0xa8, 0x0f, 0x63, 0xa1, 0x27, 0x30, 0x1f, 0x49, 0xa1, 0x41,
+	0xa8, 0x20, 0xd2, 0x5c, 0x7c, 0x03, 0xe1, 0xbe, 0x2d, 0x88, 0x06, 0xb7, 0x8d, 0x04, 0x2a, 0x41,
+	0x36, 0xac, 0x0e, 0x44, 0x20, 0xdd, 0x30, 0xf0, 0x19, 0xbd, 0x6b, 0xbc, 0xef, 0xa1, 0x6d, 0x06,
+	0x5d, 0xe1, 0xfe, 0xd0, 0x28, 0x54, 0x14, 0x02, 0xbc, 0x07, 0xea, 0x9f, 0x44, 0x02, 0x93, 0xa0,
+	0x16, 0xac, 0x0c, 0xac, 0x87, 0x7b, 0x46, 0x97, 0x26, 0x25, 0x16, 0xa4, 0xfd, 0xde, 0xe4, 0xee,
+	0xd0, 0xd5, 0x64, 0x0e, 0x67, 0x41, 0x5b, 0x59, 0xc4, 0xc3, 0x58, 0xac, 0xfc, 0x64, 0xb9, 0x40,
+	0xa4, 0x41, 0xcb, 0xe6, 0x6d, 0xa5, 0xd8, 0x96, 0xb4, 0xc0, 0xcb, 0x4f, 0x0a, 0xe1, 0x6d, 0x4e,
+	0x45, 0x00, 0xb8, 0xa5, 0xa8, 0x0a, 0xb3, 0x03, 0x09, 0xd4, 0xb2, 0x1b, 0x8e, 0xdf, 0xb0, 0xac,
+	0x44, 0x8e, 0x34, 0x9c, 0x11, 0x2b, 0x76, 0xc3, 0x51, 0x50, 0x7d, 0x90, 0x44, 0xf3, 0xc4, 0x42,
+	0x5b, 0x6f, 0x36, 0xa9, 0x26, 0x91, 0x23, 0xcf, 0xf5, 0xb6, 0x65, 0xea, 0x9e, 0xe3, 0x12, 0x69,
+	0x89, 0x05, 0x96, 0x79, 0x01, 0xa8, 0x32, 0xfe, 0xe3, 0x80, 0x8d, 0x1a, 0x09, 0x1d, 0x25, 0xeb,
+	0x26, 0xfd, 0x4c, 0xb1, 0xcc, 0xac, 0xba, 0x33, 0x3c, 0xc6, 0xd0, 0xd6, 0x52, 0xa4, 0x8a, 0x05,
+	0x32, 0x84, 0x43, 0xd0, 0x6b, 0x70, 0x43, 0xf4, 0x6b, 0x44, 0x5a, 0x89, 0x87, 0x78, 0x5e, 0x5b,
+	0x14, 0x3b, 0x4e, 0xd7, 0xf6, 0x94, 0x00, 0x89, 0xde, 0xe9, 0xf7, 0x86, 0x67, 0x3a, 0x0b, 0x79,
+	0xb9, 0xa1, 0xbd, 0xe1, 0x09, 0x05, 0x04, 0x5d, 0x21, 0xfb, 0x22, 0x68, 0x07, 0xc6, 0x79, 0x87,
+	0xe7, 0xf7, 0x1c, 0xc3, 0x57, 0xf5, 0x81, 0xb4, 0x47, 0x66, 0xc7, 0x1b, 0x8a, 0x40, 0x46, 0x4b,
+	0xb7, 0x83, 0xd9, 0x96, 0x38, 0x66, 0xde, 0x65, 0xe4, 0x28, 0x34, 0x08, 0x3e, 0x25, 0x86, 0x63,
+	0xa3, 0x2a, 0x71, 0xd8, 0x8f, 0x61, 0xa3, 0xcb, 0x4a, 0xae, 0x67, 0xd8, 0xd4, 0x2e, 0x7f, 0x0f,
+	0xac, 0xe9, 0x18, 0x55, 0xee, 0x04, 0xf8, 0x4b, 0xde, 0x42, 0xe1, 0x2d, 0xc8, 0x84, 0xd3, 0x22,
+	0x9a, 0x85, 0xeb, 0x2c, 0x31, 0x8a, 0x91, 0x28, 0xff, 0xa0, 0x54, 0x96, 0x56, 0xc5, 0xfc, 0x93,
+	0x7f, 0x14, 0x2c, 0x90, 0x86, 0x1d, 0x21, 0x9a, 0x82, 0x11, 0x31, 0x57, 0x1d, 0x55, 0x46, 0x2c,
+	0x13, 0xcd, 0xc1, 0x98, 0xb0, 0x8e, 0x17, 0x39, 0xe2, 0x0b, 0xdd, 0x0d, 0x9a, 0x44, 0x7f, 0x66,
+	0x90, 0x16, 0xd3, 0xcf, 0xf0, 0x88, 0xa0, 0xf0, 0x79, 0x0a, 0x32, 0xe1, 0xde, 0x9d, 0xca, 0x79,
+	0x74, 0x16, 0x10, 0x94, 0xb7, 0xc2, 0xe0, 0x49, 0x46, 0xf5, 0xcb, 0x53, 0x54, 0x86, 0xeb, 0xac,
+	0x8d, 0xe7, 0x86, 0x7f, 0xa7, 0x52, 0xb2, 0x62, 0x7b, 0x0a, 0x17, 0xa6, 0xc6, 0x8b, 0x7a, 0x96,
+	0x1b, 0x27, 0xbe, 0x0a, 0xbf, 0x4d, 0x41, 0x26, 0x7c, 0xf6, 0x2f, 0x6b, 0xd5, 0x30, 0x67, 0xec,
+	0xc1, 0x98, 0xce, 0x14, 0x49, 0xe9, 0x2b, 0x99, 0x2b, 0xa4, 0x0b, 0x4f, 0x02, 0x67, 0xb1, 0xeb,
+	0xfb, 0xb2, 0x66, 0xad, 0x41, 0x86, 0xbd, 0x0e, 0x53, 0xeb, 0xda, 0x9e, 0xd5, 0x16, 0xc6, 0x4d,
+	0x70, 0xda, 0x29, 0x25, 0x15, 0xfe, 0x93, 0x82, 0x4c, 0xb8, 0x65, 0x7f, 0x59, 0xd5, 0x15, 0xb8,
+	0x41, 0x47, 0x3c, 0x6c, 0xb6, 0x73, 0xb5, 0xa3, 0x18, 0xef, 0x58, 0x36, 0x9b, 0xf3, 0x14, 0x80,
+	0x0e, 0x7e, 0x78, 0x70, 0x21, 0xd6, 0x27, 0x58, 0x9c, 0xc9, 0x44, 0xc7, 0xb2, 0xe9, 0xfd, 0xab,
+	0x59, 0x9f, 0x60, 0x94, 0x87, 0x4c, 0x64, 0x9c, 0x35, 0xca, 0x20, 0xd0, 0xe9, 0x0f, 0xb0, 0x5e,
+	0x87, 0x79, 0x8a, 0xa0, 0x97, 0xcb, 0xd3, 0x6d, 0x93, 0xc6, 0x3c, 0x31, 0x17, 0x17, 0xe3, 0xf7,
+	0xdb, 0x1d, 0xbd, 0x57, 0xed, 0x73, 0xc5, 0x60, 0xbc, 0xf0, 0x97, 0x14, 0x4c, 0x46, 0x46, 0x0c,
+	0x2f, 0xeb, 0x81, 0xc4, 0x19, 0xdf, 0x48, 0xf2, 0x8c, 0x6f, 0xe8, 0xe4, 0x3c, 0x3d, 0x74, 0x72,
+	0x3e, 0x74, 0xec, 0x38, 0x3a, 0x74, 0xec, 0x58, 0xf8, 0x43, 0x1a, 0x50, 0xbc, 0x1e, 0x7b, 0xd9,
+	0x0d, 0xad, 0xc2, 0x04, 0x5f, 0x31, 0xdc, 0xbb, 0x00, 0x23, 0xf1, 0x7e, 0x65, 0x1d, 0x26, 0xc5,
+	0x3e, 0x35, 0x23, 0xb8, 0xd4, 0xa3, 0x4a, 0x46, 0x10, 0x4b, 0xfe, 0x8b, 0x61, 0x16, 0xf7, 0xa3,
+	0x17, 0x37, 0x78, 0x52, 0x50, 0x45, 0xf8, 0xbb, 0x0f, 0xd3, 0x41, 0x85, 0x29, 0x70, 0xfc, 0x98,
+	0xa6, 0x7c, 0xb2, 0x00, 0xee, 0xc3, 0xb8, 0xb8, 0x68, 0xd2, 0xd8, 0x95, 0xee, 0xd9, 0x18, 0xbf,
+	0x67, 0xe8, 0x08, 0xa0, 0x83, 0x4d, 0x4b, 0xe7, 0xba, 0xc6, 0xaf, 0xa4, 0xeb, 0x26, 0xd7, 0x40,
+	0xd5, 0x51, 0xbb, 0xf4, 0x1e, 0xd3, 0x75, 0xe3, 0x8a, 0x76, 0xe9, 0xbd, 0x3d, 0x8c, 0x0b, 0xbf,
+	0x49, 0xc1, 0x44, 0x68, 0xfe, 0xf8, 0xff, 0x11, 0x08, 0xbf, 0x4a, 0x01, 0x8a, 0xb7, 0x2d, 0xb1,
+	0x24, 0xf0, 0x06, 0x8c, 0x8b, 0xc6, 0x87, 0x99, 0x31, 0x50, 0xa4, 0xf0, 0x5c, 0x52, 0x62, 0xcb,
+	0xb3, 0x54, 0xa5, 0xf8, 0xe8, 0x50, 0xc0, 0x4c, 0x47, 0x02, 0xe6, 0x6b, 0x30, 0xc6, 0x9b, 0x18,
+	0x76, 0x6b, 0xa6, 0x76, 0x96, 0x93, 0xfb, 0x28, 0xd1, 0xbe, 0x08, 0x2c, 0x7a, 0x08, 0xb7, 0x62,
+	0xb5, 0x4a, 0xf0, 0xb3, 0x5b, 0x36, 0x5a, 0x7b, 0x54, 0xcc, 0xc2, 0xaf, 0x46, 0x60, 0x36, 0xa9,
+	0x1d, 0x8a, 0x6d, 0xef, 0x07, 0x70, 0x9d, 0xae, 0xc0, 0xdf, 0xc2, 0xd4, 0xce, 0xea, 0xe5, 0xfd,
+	0x14, 0x56, 0x38, 0x7a, 0xf0, 0x21, 0xa5, 0x63, 0x0f, 0x89, 0x5e, 0xfe, 0xe8, 0xa8, 0x5f, 0x3c,
+	0x92, 0x29, 0x1c, 0x19, 0xee, 0x27, 0x24, 0xd3, 0xeb, 0x09, 0xc9, 0x94, 0x3e, 0x4c, 0x17, 0x37,
+	0xba, 0xb6, 0xa9, 0xb9, 0x58, 0x27, 0x8e, 0xcd, 0x5f, 0x8a, 0x92, 0xe1, 0x44, 0x85, 0xd1, 0x42,
+	0x2e, 0x1f, 0x0f, 0xbb, 0xbc, 0xf0, 0x26, 0x4c, 0x46, 0x9a, 0x2e, 0x5a, 0x1b, 0x70, 0xc3, 0xb9,
+	0x23, 0xf8, 0x07, 0x42, 0x30, 0x1a, 0xfc, 0x60, 0x9a, 0x51, 0xd8, 0xdf, 0x85, 0x5f, 0x8f, 0xc0,
+	0xfc, 0x90, 0xfe, 0x0a, 0x6d, 0x40, 0x36, 0x54, 0x27, 0x85, 0x15, 0x4e, 0x05, 0x9d, 0x57, 0x3f,
+	0xac, 0xf4, 0xce, 0xb0, 0xc1, 0x42, 0x41, 0x7f, 0x89, 0x8c, 0x4f, 0x64, 0x46, 0xad, 0xc3, 0x64,
+	0x30, 0x61, 0x61, 0xa0, 0x34, 0x07, 0xf9, 0x44, 0x06, 0x92, 0x21, 0x1b, 0x80, 0xf8, 0x22, 0xfe,
+	0x68, 0x64, 0x31, 0xa9, 0xb8, 0xe7, 0xa6, 0x2b, 0xd3, 0xbe, 0x0c, 0xff, 0x26, 0xf4, 0xfc, 0xc2,
+	0x43, 0x1c, 0xee, 0x72, 0xc0, 0xfd, 0xc1, 0x4d, 0xdf, 0x95, 0x63, 0x11, 0x57, 0xfe, 0x3e, 0x05,
+	0xb3, 0x49, 0xcd, 0x16, 0xca, 0x01, 0xf4, 0xfb, 0x47, 0xe6, 0x86, 0x8c, 0x12, 0xa2, 0x44, 0x2e,
+	0x04, 0x37, 0x5c, 0x14, 0x66, 0x53, 0x38, 0x62, 0x2b, 0x1d, 0x11, 0x04, 0x25, 0x7c, 0xf0, 0x73,
+	0x34, 0xab, 0x2d, 0x94, 0x6c, 0xc0, 0xf0, 0x7f, 0x89, 0xee, 0x9b, 0x39, 0x1a, 0x31, 0xf3, 0x4f,
+	0x29, 0x58, 0x18, 0xda, 0x13, 0x7e, 0x87, 0x83, 0x7b, 0x61, 0xc2, 0x50, 0x61, 0x36, 0x3a, 0xac,
+	0x24, 0x86, 0x73, 0x86, 0xfd, 0x41, 0x57, 0x61, 0xa0, 0x81, 0x0c, 0xe6, 0x8f, 0x35, 0x8a, 0xe2,
+	0x0d, 0x2a, 0x32, 0x06, 0xe9, 0xa4, 0x70, 0x0e, 0x73, 0xc9, 0x68, 0xf4, 0x10, 0x90, 0x65, 0x0b,
+	0x37, 0xb0, 0x5f, 0x56, 0x28, 0x4b, 0xb8, 0x7b, 0x26, 0xcc, 0x61, 0x32, 0x31, 0x78, 0x78, 0x1b,
+	0x11, 0x38, 0xdb, 0x4d, 0xe1, 0x97, 0x30, 0x9b, 0xd4, 0xe6, 0x26, 0x9f, 0x49, 0x6a, 0xc8, 0x99,
+	0x0c, 0xdc, 0xad, 0x91, 0x4b, 0xee, 0x56, 0x24, 0x32, 0x16, 0x1e, 0xc3, 0xd2, 0x25, 0x65, 0xff,
+	0xa0, 0xde, 0xd4, 0x25, 0x7a, 0x23, 0x25, 0x6a, 0xc1, 0x82, 0x85, 0xa1, 0xcd, 0xee, 0xff, 0x76,
+	0x6b, 0x85, 0x7f, 0x8d, 0xc0, 0x4c, 0xac, 0x71, 0xfd, 0x6e, 0x6b, 0xac, 0x41, 0x86, 0x78, 0xba,
+	0xeb, 0x69, 0x91, 0xbd, 0x4c, 0x30, 0x9a, 0xf0, 0xc4, 0x1a, 0x64, 0x2c, 0xdb, 0xc4, 0x3d, 0xcd,
+	0x69, 0x34, 0x08, 0xf6, 0xdd, 0x38, 0xc1, 0x68, 0x55, 0x46, 0xa2, 0xe5, 0x98, 0x18, 0x4b, 0x47,
+	0x7f, 0x3f, 0x16, 0xef, 0x04, 0x71, 0x66, 0xf8, 0x07, 0x63, 0xfa, 0x2a, 0x84, 0x88, 0x08, 0xc8,
+	0x3d, 0x3f, 0x16, 0x4f, 0x71, 0x3a, 0x2f, 0xa2, 0x7b, 0x04, 0xbd, 0x01, 0x92, 0x40, 0x0e, 0x0e,
+	0xea, 0x89, 0x08, 0x17, 0x62, 0xf1, 0xe8, 0xc8, 0x9d, 0xa0, 0xf7, 0xe0, 0x96, 0x10, 0x8c, 0x4c,
+	0x85, 0xc7, 0xf3, 0xe9, 0x8d, 0xa9, 0x68, 0x00, 0xab, 0x06, 0xb3, 0x60, 0xf5, 0xe2, 0x0c, 0x2b,
+	0x33, 0x5c, 0xac, 0x4f, 0x25, 0x9b, 0x7f, 0x4c, 0xc1, 0x5c, 0xf2, 0x6f, 0x87, 0x68, 0x03, 0xee,
+	0xec, 0x16, 0xd5, 0xd2, 0x81, 0x56, 0x93, 0x0f, 0xe5, 0x92, 0x5a, 0xa9, 0x1e, 0x6b, 0x35, 0x55,
+	0x29, 0xaa, 0xf2, 0xfe, 0x53, 0xed, 0xf4, 0xb8, 0x76, 0x22, 0x97, 0x2a, 0x7b, 0x15, 0xb9, 0x9c,
+	0xbd, 0x86, 0xee, 0xc3, 0xfa, 0x50, 0xe4, 0x9e, 0x2c, 0x6b, 0xfb, 0x8a, 0x2c, 0x97, 0x9f, 0x66,
+	0x53, 0x68, 0x0d, 0x56, 0x86, 0x03, 0x2b, 0x7b, 0xd5, 0xec, 0x08, 0x5a, 0x87, 0xd5, 0xa1, 0x90,
+	0x83, 0xa7, 0xbb, 0x4a, 0xa5, 0x9c, 0x4d, 0x6f, 0xfe, 0x3d, 0x05, 0x2b, 0x97, 0xfe, 0x58, 0x82,
+	0x1e, 0xc1, 0x43, 0x59, 0x3d, 0x90, 0x15, 0xf9, 0xf4, 0x48, 0x93, 0x1f, 0xcb, 0xc7, 0xaa, 0xf6,
+	0xb8, 0xaa, 0xca, 0x5a, 0xed, 0xb0, 0x58, 0x3b, 0xa8, 0x1c, 0xef, 0x6b, 0x47, 0xd5, 0xb2, 0x3c,
+	0xb0, 0x8b, 0x2d, 0xd8, 0x7c, 0xb1, 0x48, 0xb9, 0x52, 0x2b, 0xee, 0x1e, 0xca, 0xe5, 0x6c, 0x0a,
+	0x6d, 0xc2, 0xbd, 0x17, 0xe3, 0xdf, 0x2b, 0x56, 0x0e, 0xb3, 0x23, 0xe8, 0x01, 0xdc, 0x7f, 0x31,
+	0x96, 0x7d, 0x65, 0xd3, 0x9b, 0xbf, 0x4b, 0x41, 0x76, 0xb0, 0x84, 0xa1, 0xae, 0xfb, 0xe0, 0xb4,
+	0xa8, 0x14, 0x8f, 0xd5, 0xca, 0xb1, 0xac, 0xd5, 0xd4, 0xa2, 0x7a, 0x5a, 0x1b, 0xd8, 0x40, 0x22,
+	0xa4, 0x4f, 0xa1, 0x36, 0xe7, 0x60, 0x31, 0x0e, 0x51, 0xe4, 0x43, 0xb9, 0x58, 0x93, 0xcb, 0xd9,
+	0x91, 0x61, 0x7c, 0xf5, 0x54, 0xa1, 0xf2, 0xe9, 0xcd, 0x7f, 0xa7, 0xe0, 0x56, 0x42, 0x41, 0x83,
+	0xee, 0x41, 0xa1, 0x26, 0x1f, 0x97, 0x35, 0xb5, 0xaa, 0x05, 0xfb, 0xa4, 0xd2, 0x72, 0xdc, 0xc4,
+	0x21, 0xb8, 0x93, 0x6a, 0x95, 0xbb, 0xb5, 0x00, 0xb9, 0x21, 0x10, 0x76, 0x2f, 0x98, 0x99, 0xeb,
+	0xb0, 0x3a, 0x04, 0x23, 0x3f, 0x91, 0x4b, 0xa7, 0x2a, 0xb5, 0xf5, 0x12, 0x50, 0xa9, 0x78, 0x5c,
+	0x92, 0xe9, 0x6a, 0xa3, 0x97, 0x80, 0x14, 0x79, 0xef, 0xf4, 0xb8, 0x2c, 0x97, 0xb3, 0xd7, 0x37,
+	0x3f, 0x4f, 0xc1, 0x54, 0xf4, 0x29, 0xa1, 0x3c, 0x2c, 0x57, 0x4f, 0xd5, 0xfd, 0x2a, 0x3d, 0x3c,
+	0xf5, 0x89, 0xa6, 0x3e, 0x3d, 0x19, 0xdc, 0xea, 0x2a, 0x2c, 0xc5, 0x10, 0xb5, 0xca, 0xfe, 0xb1,
+	0xac, 0x68, 0x35, 0x59, 0xcd, 0xa6, 0xd0, 0x22, 0xcc, 0xc5, 0x00, 0x6c, 0x8b, 0xd9, 0x11, 0xea,
+	0x84, 0x18, 0xaf, 0x54, 0x3d, 0x56, 0x95, 0x62, 0x49, 0xd5, 0x4a, 0xc5, 0xc3, 0xc3, 0x6c, 0x7a,
+	0xf7, 0xf4, 0x8b, 0x6f, 0x72, 0xa9, 0x2f, 0xbf, 0xc9, 0xa5, 0xfe, 0xf9, 0x4d, 0x2e, 0xf5, 0xd9,
+	0xb7, 0xb9, 0x6b, 0x5f, 0x7e, 0x9b, 0xbb, 0xf6, 0xb7, 0x6f, 0x73, 0xd7, 0x7e, 0xfa, 0x76, 0xa8,
+	0xb6, 0x3f, 0xc3, 0xcd, 0xe6, 0xc5, 0x47, 0xe7, 0xfe, 0x7f, 0x93, 0x7b, 0xc8, 0xa7, 0x7f, 0xdb,
+	0x1d, 0xc7, 0xec, 0xb6, 0xf1, 0xf6, 0xf9, 0xce, 0x76, 0xcf, 0x67, 0xf1, 0xa2, 0xbf, 0x3e, 0xc6,
+	0x7e, 0x14, 0xfa, 0xfe, 0x7f, 0x07, 0x00, 0xe3, 0xf8, 0xfc, 0xf1, 0xbb, 0x27, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleLagEventThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OracleLagEventThreshold))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.BridgeSigningMaxMissedRatio.Size()
+		i -= size
+		if _, err := m.BridgeSigningMaxMissedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
+	if m.BridgeSigningWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeSigningWindow))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
+	{
+		size := m.SlashFractionContractCallTx.Size()
+		i -= size
+		if _, err := m.SlashFractionContractCallTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xc2
+	if m.EthereumEventVoteSlashingMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumEventVoteSlashingMode))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.ConflictingEthereumEventGraceWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ConflictingEthereumEventGraceWindow))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ConflictingEthereumEventGraceVotes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ConflictingEthereumEventGraceVotes))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.ConflictingEthereumEventSlashing {
+		i--
+		if m.ConflictingEthereumEventSlashing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.SlashFractionBadEthereumSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionBadEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x9a
+	if m.SignerSetMaxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignerSetMaxAge))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.SignerSetPowerDiffThreshold.Size()
+		i -= size
+		if _, err := m.SignerSetPowerDiffThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x8a
+	if m.ExecutedBatchStatsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutedBatchStatsWindow))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.BatchSettingsOverrides) > 0 {
+		for iNdEx := len(m.BatchSettingsOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchSettingsOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.BatchTriggers) > 0 {
+		for iNdEx := len(m.BatchTriggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchTriggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	{
+		size := m.BatchOldestShare.Size()
+		i -= size
+		if _, err := m.BatchOldestShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.BatchSelectionStrategy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchSelectionStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.SendToEthereumStatusRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SendToEthereumStatusRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.InflowLimits) > 0 {
+		for iNdEx := len(m.InflowLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflowLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.OutflowLimits) > 0 {
+		for iNdEx := len(m.OutflowLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutflowLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.MinBridgeFees) > 0 {
+		for iNdEx := len(m.MinBridgeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBridgeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.SendToEthereumMaxBatchTimeouts != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SendToEthereumMaxBatchTimeouts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.SendToEthereumMaxPoolAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SendToEthereumMaxPoolAge))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ObserveEthereumHeightPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ObserveEthereumHeightPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.BatchMaxElement != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchMaxElement))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.BatchCreationPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchCreationPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.BridgeActive {
+		i--
+		if m.BridgeActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.UnbondSlashingSignerSetTxsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.SlashFractionConflictingEthereumSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionConflictingEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.SlashFractionEthereumSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionSignerSetTx.Size()
+		i -= size
+		if _, err := m.SlashFractionSignerSetTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AverageBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TargetEthTxTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetEthTxTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EthereumSignaturesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumSignaturesWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SignedSignerSetTxsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedSignerSetTxsWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractSourceHash) > 0 {
+		i -= len(m.ContractSourceHash)
+		copy(dAtA[i:], m.ContractSourceHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UntrackedEthereumEventAcceptedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UntrackedEthereumEventAcceptedHeight))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LastSignerSetChangeBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSignerSetChangeBlockHeight))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Inflows) > 0 {
+		for iNdEx := len(m.Inflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.OutflowPauses) > 0 {
+		for iNdEx := len(m.OutflowPauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutflowPauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Outflows) > 0 {
+		for iNdEx := len(m.Outflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.SendToEthereumPoolRecords) > 0 {
+		for iNdEx := len(m.SendToEthereumPoolRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendToEthereumPoolRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.LaggingOracleValidators) > 0 {
+		for iNdEx := len(m.LaggingOracleValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LaggingOracleValidators[iNdEx])
+			copy(dAtA[i:], m.LaggingOracleValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.LaggingOracleValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.BridgeSigningInfos) > 0 {
+		for iNdEx := len(m.BridgeSigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeSigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if m.LastBridgeInactiveHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBridgeInactiveHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.EthereumEventExcusedNonces) > 0 {
+		for iNdEx := len(m.EthereumEventExcusedNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumEventExcusedNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.EthereumEventAcceptedHeights) > 0 {
+		for iNdEx := len(m.EthereumEventAcceptedHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumEventAcceptedHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.ConflictingEventVotes) > 0 {
+		for iNdEx := len(m.ConflictingEventVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConflictingEventVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.BadSignatureEvidenceFloor != nil {
+		{
+			size, err := m.BadSignatureEvidenceFloor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.BadSignatureEvidence) > 0 {
+		for iNdEx := len(m.BadSignatureEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadSignatureEvidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.EthereumSignatureCheckpoints) > 0 {
+		for iNdEx := len(m.EthereumSignatureCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EthereumSignatureCheckpoints[iNdEx])
+			copy(dAtA[i:], m.EthereumSignatureCheckpoints[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumSignatureCheckpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.SignerSetHijackIncidents) > 0 {
+		for iNdEx := len(m.SignerSetHijackIncidents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSetHijackIncidents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.SignerSetHashes) > 0 {
+		for iNdEx := len(m.SignerSetHashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSetHashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ExecutedBatchStats) > 0 {
+		for iNdEx := len(m.ExecutedBatchStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutedBatchStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.SendToEthereumStatuses) > 0 {
+		for iNdEx := len(m.SendToEthereumStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendToEthereumStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.EthereumDenylist) > 0 {
+		for iNdEx := len(m.EthereumDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EthereumDenylist[iNdEx])
+			copy(dAtA[i:], m.EthereumDenylist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for iNdEx := len(m.QuarantinedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuarantinedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.EthereumEventVoteRecords) > 0 {
+		for iNdEx := len(m.EthereumEventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumEventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastObservedEventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToEthereumPoolRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToEthereumPoolRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToEthereumPoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchTimeouts != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchTimeouts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindowAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PausedUntil != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PausedUntil))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxOutstandingBatches != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxOutstandingBatches))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPoolAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPoolAge))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinPoolSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinPoolSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinFees.Size()
+		i -= size
+		if _, err := m.MinFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchCreationPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchCreationPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetEthTxTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetEthTxTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchMaxElement != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchMaxElement))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedBatchStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedBatchStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedBatchStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MedianFee.Size()
+		i -= size
+		if _, err := m.MedianFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ExecutedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ElementCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ElementCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SendToEthereumId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SendToEthereumId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToEthereumStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToEthereumStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToEthereumStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundReason) > 0 {
+		i -= len(m.RefundReason)
+		copy(dAtA[i:], m.RefundReason)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BatchTimeouts != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchTimeouts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetHijackIncident) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetHijackIncident) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetHijackIncident) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ObservedSigners) > 0 {
+		for iNdEx := len(m.ObservedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservedSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ObservedHash) > 0 {
+		i -= len(m.ObservedHash)
+		copy(dAtA[i:], m.ObservedHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ObservedHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExpectedHash) > 0 {
+		i -= len(m.ExpectedHash)
+		copy(dAtA[i:], m.ExpectedHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExpectedHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BadSignatureEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadSignatureEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadSignatureEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checkpoint) > 0 {
+		i -= len(m.Checkpoint)
+		copy(dAtA[i:], m.Checkpoint)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Checkpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BadSignatureEvidenceFloor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadSignatureEvidenceFloor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadSignatureEvidenceFloor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractCallScopes) > 0 {
+		for iNdEx := len(m.ContractCallScopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCallScopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallScopeFloor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallScopeFloor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallScopeFloor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictingEventVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingEventVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingEventVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumEventAcceptedHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumEventAcceptedHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumEventAcceptedHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumEventExcusedNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumEventExcusedNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumEventExcusedNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedOutgoingTxs) > 0 {
+		dAtA5 := make([]byte, len(m.MissedOutgoingTxs)*10)
+		var j4 int
+		for _, num := range m.MissedOutgoingTxs {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGenesis(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MissedContractCallTxs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissedContractCallTxs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MissedBatchTxs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissedBatchTxs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedSignerSetTxs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissedSignerSetTxs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ContractSourceHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	}
+	if m.SignedSignerSetTxsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedSignerSetTxsWindow))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	}
+	if m.EthereumSignaturesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.EthereumSignaturesWindow))
+	}
+	if m.TargetEthTxTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetEthTxTimeout))
+	}
+	if m.AverageBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	}
+	l = m.SlashFractionSignerSetTx.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionBatch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionEthereumSignature.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionConflictingEthereumSignature.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.UnbondSlashingSignerSetTxsWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.UnbondSlashingSignerSetTxsWindow))
+	}
+	if m.BridgeActive {
+		n += 3
+	}
+	if m.BatchCreationPeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchCreationPeriod))
+	}
+	if m.BatchMaxElement != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchMaxElement))
+	}
+	if m.ObserveEthereumHeightPeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.ObserveEthereumHeightPeriod))
+	}
+	if m.SendToEthereumMaxPoolAge != 0 {
+		n += 2 + sovGenesis(uint64(m.SendToEthereumMaxPoolAge))
+	}
+	if m.SendToEthereumMaxBatchTimeouts != 0 {
+		n += 2 + sovGenesis(uint64(m.SendToEthereumMaxBatchTimeouts))
+	}
+	if len(m.MinBridgeFees) > 0 {
+		for _, e := range m.MinBridgeFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutflowLimits) > 0 {
+		for _, e := range m.OutflowLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InflowLimits) > 0 {
+		for _, e := range m.InflowLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SendToEthereumStatusRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.SendToEthereumStatusRetention))
+	}
+	if m.BatchSelectionStrategy != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchSelectionStrategy))
+	}
+	l = m.BatchOldestShare.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.BatchTriggers) > 0 {
+		for _, e := range m.BatchTriggers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchSettingsOverrides) > 0 {
+		for _, e := range m.BatchSettingsOverrides {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ExecutedBatchStatsWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.ExecutedBatchStatsWindow))
+	}
+	l = m.SignerSetPowerDiffThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.SignerSetMaxAge != 0 {
+		n += 2 + sovGenesis(uint64(m.SignerSetMaxAge))
+	}
+	l = m.SlashFractionBadEthereumSignature.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.ConflictingEthereumEventSlashing {
+		n += 3
+	}
+	if m.ConflictingEthereumEventGraceVotes != 0 {
+		n += 2 + sovGenesis(uint64(m.ConflictingEthereumEventGraceVotes))
+	}
+	if m.ConflictingEthereumEventGraceWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.ConflictingEthereumEventGraceWindow))
+	}
+	if m.EthereumEventVoteSlashingMode != 0 {
+		n += 2 + sovGenesis(uint64(m.EthereumEventVoteSlashingMode))
+	}
+	l = m.SlashFractionContractCallTx.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.BridgeSigningWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.BridgeSigningWindow))
+	}
+	l = m.BridgeSigningMaxMissedRatio.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.OracleLagEventThreshold != 0 {
+		n += 2 + sovGenesis(uint64(m.OracleLagEventThreshold))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedEventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for _, e := range m.OutgoingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for _, e := range m.Confirmations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumEventVoteRecords) > 0 {
+		for _, e := range m.EthereumEventVoteRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for _, e := range m.UnbatchedSendToEthereumTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuarantinedDeposits) > 0 {
+		for _, e := range m.QuarantinedDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumDenylist) > 0 {
+		for _, s := range m.EthereumDenylist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendToEthereumStatuses) > 0 {
+		for _, e := range m.SendToEthereumStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutedBatchStats) > 0 {
+		for _, e := range m.ExecutedBatchStats {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignerSetHashes) > 0 {
+		for _, e := range m.SignerSetHashes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignerSetHijackIncidents) > 0 {
+		for _, e := range m.SignerSetHijackIncidents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumSignatureCheckpoints) > 0 {
+		for _, b := range m.EthereumSignatureCheckpoints {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BadSignatureEvidence) > 0 {
+		for _, e := range m.BadSignatureEvidence {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BadSignatureEvidenceFloor != nil {
+		l = m.BadSignatureEvidenceFloor.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ConflictingEventVotes) > 0 {
+		for _, e := range m.ConflictingEventVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumEventAcceptedHeights) > 0 {
+		for _, e := range m.EthereumEventAcceptedHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumEventExcusedNonces) > 0 {
+		for _, e := range m.EthereumEventExcusedNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastBridgeInactiveHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastBridgeInactiveHeight))
+	}
+	if len(m.BridgeSigningInfos) > 0 {
+		for _, e := range m.BridgeSigningInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LaggingOracleValidators) > 0 {
+		for _, s := range m.LaggingOracleValidators {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendToEthereumPoolRecords) > 0 {
+		for _, e := range m.SendToEthereumPoolRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Outflows) > 0 {
+		for _, e := range m.Outflows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutflowPauses) > 0 {
+		for _, e := range m.OutflowPauses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Inflows) > 0 {
+		for _, e := range m.Inflows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSignerSetChangeBlockHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSignerSetChangeBlockHeight))
+	}
+	if m.UntrackedEthereumEventAcceptedHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.UntrackedEthereumEventAcceptedHeight))
+	}
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *SendToEthereumPoolRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.BatchTimeouts != 0 {
+		n += 1 + sovGenesis(uint64(m.BatchTimeouts))
+	}
+	return n
+}
+
+func (m *OutflowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovGenesis(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *WindowAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OutflowPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PausedUntil != 0 {
+		n += 1 + sovGenesis(uint64(m.PausedUntil))
+	}
+	return n
+}
+
+func (m *BatchTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MinFees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MinPoolSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MinPoolSize))
+	}
+	if m.MaxPoolAge != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPoolAge))
+	}
+	if m.MaxOutstandingBatches != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxOutstandingBatches))
+	}
+	return n
+}
+
+func (m *BatchSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BatchMaxElement != 0 {
+		n += 1 + sovGenesis(uint64(m.BatchMaxElement))
+	}
+	if m.TargetEthTxTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetEthTxTimeout))
+	}
+	if m.BatchCreationPeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.BatchCreationPeriod))
+	}
+	return n
+}
+
+func (m *ExecutedBatchStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.BatchNonce))
+	}
+	if m.ElementCount != 0 {
+		n += 1 + sovGenesis(uint64(m.ElementCount))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.CreatedHeight))
+	}
+	if m.ExecutedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ExecutedHeight))
+	}
+	l = m.MinFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MedianFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *InflowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovGenesis(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QuarantinedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
+	if m.SendToEthereumId != 0 {
+		n += 1 + sovGenesis(uint64(m.SendToEthereumId))
+	}
+	return n
+}
+
+func (m *SendToEthereumStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	if m.State != 0 {
+		n += 1 + sovGenesis(uint64(m.State))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.BatchNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EthereumHeight))
+	}
+	if m.BatchTimeouts != 0 {
+		n += 1 + sovGenesis(uint64(m.BatchTimeouts))
+	}
+	l = len(m.RefundReason)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SignerSetHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetHijackIncident) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.SignerSetNonce))
+	}
+	l = len(m.ExpectedHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ObservedHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ObservedSigners) > 0 {
+		for _, e := range m.ObservedSigners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BadSignatureEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checkpoint)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BadSignatureEvidenceFloor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.SignerSetNonce))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.BatchNonce))
+	}
+	if len(m.ContractCallScopes) > 0 {
+		for _, e := range m.ContractCallScopes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractCallScopeFloor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.InvalidationNonce))
+	}
+	return n
+}
+
+func (m *ConflictingEventVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EthereumEventAcceptedHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EthereumEventExcusedNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *BridgeSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovGenesis(uint64(m.IndexOffset))
+	}
+	if m.MissedSignerSetTxs != 0 {
+		n += 1 + sovGenesis(uint64(m.MissedSignerSetTxs))
+	}
+	if m.MissedBatchTxs != 0 {
+		n += 1 + sovGenesis(uint64(m.MissedBatchTxs))
+	}
+	if m.MissedContractCallTxs != 0 {
+		n += 1 + sovGenesis(uint64(m.MissedContractCallTxs))
+	}
+	if len(m.MissedOutgoingTxs) > 0 {
+		l = 0
+		for _, e := range m.MissedOutgoingTxs {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+			}
+			m.SignedSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+			}
+			m.EthereumSignaturesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			}
+			m.TargetEthTxTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+			}
+			m.UnbondSlashingSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeActive = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchCreationPeriod", wireType)
+			}
+			m.BatchCreationPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchCreationPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxElement", wireType)
+			}
+			m.BatchMaxElement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchMaxElement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserveEthereumHeightPeriod", wireType)
+			}
+			m.ObserveEthereumHeightPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserveEthereumHeightPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereumMaxPoolAge", wireType)
+			}
+			m.SendToEthereumMaxPoolAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendToEthereumMaxPoolAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereumMaxBatchTimeouts", wireType)
+			}
+			m.SendToEthereumMaxBatchTimeouts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendToEthereumMaxBatchTimeouts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBridgeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBridgeFees = append(m.MinBridgeFees, ERC20Token{})
+			if err := m.MinBridgeFees[len(m.MinBridgeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutflowLimits = append(m.OutflowLimits, OutflowLimit{})
+			if err := m.OutflowLimits[len(m.OutflowLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflowLimits = append(m.InflowLimits, InflowLimit{})
+			if err := m.InflowLimits[len(m.InflowLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereumStatusRetention", wireType)
+			}
+			m.SendToEthereumStatusRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendToEthereumStatusRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSelectionStrategy", wireType)
+			}
+			m.BatchSelectionStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSelectionStrategy |= BatchSelectionStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchOldestShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchOldestShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTriggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchTriggers = append(m.BatchTriggers, BatchTrigger{})
+			if err := m.BatchTriggers[len(m.BatchTriggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSettingsOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchSettingsOverrides = append(m.BatchSettingsOverrides, BatchSettings{})
+			if err := m.BatchSettingsOverrides[len(m.BatchSettingsOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBatchStatsWindow", wireType)
+			}
+			m.ExecutedBatchStatsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedBatchStatsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetPowerDiffThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerSetPowerDiffThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetMaxAge", wireType)
+			}
+			m.SignerSetMaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetMaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBadEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingEthereumEventSlashing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConflictingEthereumEventSlashing = bool(v != 0)
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingEthereumEventGraceVotes", wireType)
+			}
+			m.ConflictingEthereumEventGraceVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictingEthereumEventGraceVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingEthereumEventGraceWindow", wireType)
+			}
+			m.ConflictingEthereumEventGraceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictingEthereumEventGraceWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteSlashingMode", wireType)
+			}
+			m.EthereumEventVoteSlashingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumEventVoteSlashingMode |= EthereumEventVoteSlashingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionContractCallTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionContractCallTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSigningWindow", wireType)
+			}
+			m.BridgeSigningWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeSigningWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSigningMaxMissedRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeSigningMaxMissedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleLagEventThreshold", wireType)
+			}
+			m.OracleLagEventThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleLagEventThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, &types.Any{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
+			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantinedDeposits = append(m.QuarantinedDeposits, &QuarantinedDeposit{})
+			if err := m.QuarantinedDeposits[len(m.QuarantinedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumDenylist = append(m.EthereumDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereumStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendToEthereumStatuses = append(m.SendToEthereumStatuses, &SendToEthereumStatus{})
+			if err := m.SendToEthereumStatuses[len(m.SendToEthereumStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBatchStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedBatchStats = append(m.ExecutedBatchStats, &ExecutedBatchStats{})
+			if err := m.ExecutedBatchStats[len(m.ExecutedBatchStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerSetHashes = append(m.SignerSetHashes, &SignerSetHash{})
+			if err := m.SignerSetHashes[len(m.SignerSetHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetHijackIncidents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerSetHijackIncidents = append(m.SignerSetHijackIncidents, &SignerSetHijackIncident{})
+			if err := m.SignerSetHijackIncidents[len(m.SignerSetHijackIncidents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignatureCheckpoints", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSignatureCheckpoints = append(m.EthereumSignatureCheckpoints, make([]byte, postIndex-iNdEx))
+			copy(m.EthereumSignatureCheckpoints[len(m.EthereumSignatureCheckpoints)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadSignatureEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadSignatureEvidence = append(m.BadSignatureEvidence, &BadSignatureEvidence{})
+			if err := m.BadSignatureEvidence[len(m.BadSignatureEvidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadSignatureEvidenceFloor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BadSignatureEvidenceFloor == nil {
+				m.BadSignatureEvidenceFloor = &BadSignatureEvidenceFloor{}
+			}
+			if err := m.BadSignatureEvidenceFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingEventVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictingEventVotes = append(m.ConflictingEventVotes, &ConflictingEventVote{})
+			if err := m.ConflictingEventVotes[len(m.ConflictingEventVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventAcceptedHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumEventAcceptedHeights = append(m.EthereumEventAcceptedHeights, &EthereumEventAcceptedHeight{})
+			if err := m.EthereumEventAcceptedHeights[len(m.EthereumEventAcceptedHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventExcusedNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumEventExcusedNonces = append(m.EthereumEventExcusedNonces, &EthereumEventExcusedNonce{})
+			if err := m.EthereumEventExcusedNonces[len(m.EthereumEventExcusedNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBridgeInactiveHeight", wireType)
+			}
+			m.LastBridgeInactiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBridgeInactiveHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeSigningInfos = append(m.BridgeSigningInfos, &BridgeSigningInfo{})
+			if err := m.BridgeSigningInfos[len(m.BridgeSigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaggingOracleValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LaggingOracleValidators = append(m.LaggingOracleValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereumPoolRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendToEthereumPoolRecords = append(m.SendToEthereumPoolRecords, &SendToEthereumPoolRecord{})
+			if err := m.SendToEthereumPoolRecords[len(m.SendToEthereumPoolRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outflows = append(m.Outflows, &WindowAmount{})
+			if err := m.Outflows[len(m.Outflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowPauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutflowPauses = append(m.OutflowPauses, &OutflowPause{})
+			if err := m.OutflowPauses[len(m.OutflowPauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inflows = append(m.Inflows, &WindowAmount{})
+			if err := m.Inflows[len(m.Inflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSignerSetChangeBlockHeight", wireType)
+			}
+			m.LastSignerSetChangeBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSignerSetChangeBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntrackedEthereumEventAcceptedHeight", wireType)
+			}
+			m.UntrackedEthereumEventAcceptedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UntrackedEthereumEventAcceptedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereumPoolRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereumPoolRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereumPoolRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeouts", wireType)
+			}
+			m.BatchTimeouts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeouts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedUntil", wireType)
+			}
+			m.PausedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolSize", wireType)
+			}
+			m.MinPoolSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPoolSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolAge", wireType)
+			}
+			m.MaxPoolAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoolAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutstandingBatches", wireType)
+			}
+			m.MaxOutstandingBatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutstandingBatches |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxElement", wireType)
+			}
+			m.BatchMaxElement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchMaxElement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			}
+			m.TargetEthTxTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchCreationPeriod", wireType)
+			}
+			m.BatchCreationPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchCreationPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutedBatchStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutedBatchStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutedBatchStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElementCount", wireType)
+			}
+			m.ElementCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElementCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedHeight", wireType)
+			}
+			m.ExecutedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MedianFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &SendToCosmosEvent{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= QuarantineStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereumId", wireType)
+			}
+			m.SendToEthereumId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendToEthereumId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereumStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereumStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereumStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= SendToEthereumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeouts", wireType)
+			}
+			m.BatchTimeouts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeouts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SignerSetHash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -975,17 +8785,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerSetHash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerSetHash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -995,29 +8805,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1027,61 +8824,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetHijackIncident) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetHijackIncident: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetHijackIncident: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
 			}
-			m.BridgeChainId = 0
+			m.SignerSetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1091,16 +8908,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedHash", wireType)
 			}
-			m.SignedSignerSetTxsWindow = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1110,35 +8927,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedBatchesWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSignaturesWindow = 0
+			m.ExpectedHash = append(m.ExpectedHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExpectedHash == nil {
+				m.ExpectedHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedHash", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1148,16 +8961,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.TargetEthTxTimeout = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedHash = append(m.ObservedHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ObservedHash == nil {
+				m.ObservedHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedSigners", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1167,16 +8995,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedSigners = append(m.ObservedSigners, &EthereumSigner{})
+			if err := m.ObservedSigners[len(m.ObservedSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.AverageBlockTime = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1186,16 +9029,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.AverageEthereumBlockTime = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1205,14 +9048,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BadSignatureEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadSignatureEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1239,15 +9132,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Checkpoint = append(m.Checkpoint[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checkpoint == nil {
+				m.Checkpoint = []byte{}
 			}
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1257,30 +9151,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1290,30 +9183,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1323,30 +9215,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BadSignatureEvidenceFloor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 17:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadSignatureEvidenceFloor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadSignatureEvidenceFloor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
 			}
-			m.UnbondSlashingSignerSetTxsWindow = 0
+			m.SignerSetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1356,16 +9284,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
 			}
-			var v int
+			m.BatchNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1375,17 +9303,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BatchNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BridgeActive = bool(v != 0)
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchCreationPeriod", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallScopes", wireType)
 			}
-			m.BatchCreationPeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1395,16 +9322,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchCreationPeriod |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxElement", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.BatchMaxElement = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCallScopes = append(m.ContractCallScopes, &ContractCallScopeFloor{})
+			if err := m.ContractCallScopes[len(m.ContractCallScopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallScopeFloor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallScopeFloor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallScopeFloor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1414,16 +9406,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchMaxElement |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserveEthereumHeightPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			m.ObserveEthereumHeightPeriod = 0
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1433,7 +9440,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObserveEthereumHeightPeriod |= uint64(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1459,7 +9466,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *ConflictingEventVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1482,17 +9489,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConflictingEventVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConflictingEventVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1502,33 +9509,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.LastObservedEventNonce = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1538,16 +9541,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1557,65 +9560,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
-			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumEventAcceptedHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirmations = append(m.Confirmations, &types.Any{})
-			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumEventAcceptedHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumEventAcceptedHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var msglen int
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1625,31 +9629,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
-			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1659,31 +9648,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumEventExcusedNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 11:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumEventExcusedNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumEventExcusedNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1693,31 +9717,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var msglen int
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1727,26 +9749,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
-			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1768,7 +9775,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+func (m *BridgeSigningInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,15 +9798,15 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeSigningInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1827,13 +9834,13 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1843,24 +9850,156 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSignerSetTxs", wireType)
+			}
+			m.MissedSignerSetTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedSignerSetTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBatchTxs", wireType)
+			}
+			m.MissedBatchTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBatchTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedContractCallTxs", wireType)
+			}
+			m.MissedContractCallTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedContractCallTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v OutgoingTxType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OutgoingTxType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissedOutgoingTxs = append(m.MissedOutgoingTxs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.MissedOutgoingTxs) == 0 {
+					m.MissedOutgoingTxs = make([]OutgoingTxType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v OutgoingTxType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OutgoingTxType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissedOutgoingTxs = append(m.MissedOutgoingTxs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedOutgoingTxs", wireType)
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
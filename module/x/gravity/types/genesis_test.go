@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -88,6 +90,101 @@ func TestGenesisStateValidate(t *testing.T) {
 				},
 			},
 		}, expErr: true},
+		"duplicate min bridge fees": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.MinBridgeFees = []ERC20Token{
+					NewERC20Token(10, common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")),
+					NewERC20Token(20, common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")),
+				}
+				return p
+			}(),
+		}, expErr: true},
+		"zero outflow window": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.OutflowLimits = []OutflowLimit{{
+					TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+					Limit:         sdk.NewInt(1000),
+				}}
+				return p
+			}(),
+		}, expErr: true},
+		"zero inflow window": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.InflowLimits = []InflowLimit{{
+					TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+					Limit:         sdk.NewInt(1000),
+				}}
+				return p
+			}(),
+		}, expErr: true},
+		"duplicate denylisted address": {src: &GenesisState{
+			Params: DefaultParams(),
+			EthereumDenylist: []string{
+				"0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+				"0xd041c41ea1bf0f006adbb6d2c9ef9d425de5ead7",
+			},
+		}, expErr: true},
+		"unspecified batch selection strategy": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.BatchSelectionStrategy = BatchSelectionStrategy_BATCH_SELECTION_STRATEGY_UNSPECIFIED
+				return p
+			}(),
+		}, expErr: true},
+		"duplicate batch trigger": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.BatchTriggers = []BatchTrigger{
+					{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", MinFees: sdk.NewInt(10)},
+					{TokenContract: "0x429881672b9ae42b8eba0e26cd9c73711b891ca5", MinFees: sdk.ZeroInt(), MinPoolSize: 5},
+				}
+				return p
+			}(),
+		}, expErr: true},
+		"batch oldest share over one": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.BatchOldestShare = sdk.NewDecWithPrec(11, 1)
+				return p
+			}(),
+		}, expErr: true},
+		"negative signer set power diff threshold": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.SignerSetPowerDiffThreshold = sdk.NewDecWithPrec(-5, 2)
+				return p
+			}(),
+		}, expErr: true},
+		"signer set hash": {src: &GenesisState{
+			Params:          DefaultParams(),
+			SignerSetHashes: []*SignerSetHash{{Nonce: 1, Hash: EthereumSigners{}.Hash()}},
+		}, expErr: false},
+		"truncated signer set hash": {src: &GenesisState{
+			Params:          DefaultParams(),
+			SignerSetHashes: []*SignerSetHash{{Nonce: 1, Hash: []byte{0x1}}},
+		}, expErr: true},
+		"bad signature evidence": {src: &GenesisState{
+			Params:                       DefaultParams(),
+			EthereumSignatureCheckpoints: [][]byte{make([]byte, 32)},
+			BadSignatureEvidence: []*BadSignatureEvidence{{
+				Checkpoint:     make([]byte, 32),
+				EthereumSigner: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			}},
+		}, expErr: false},
+		"truncated ethereum signature checkpoint": {src: &GenesisState{
+			Params:                       DefaultParams(),
+			EthereumSignatureCheckpoints: [][]byte{{0x1}},
+		}, expErr: true},
+		"invalid bad signature evidence signer": {src: &GenesisState{
+			Params: DefaultParams(),
+			BadSignatureEvidence: []*BadSignatureEvidence{{
+				Checkpoint:     make([]byte, 32),
+				EthereumSigner: "0xinvalid",
+			}},
+		}, expErr: true},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -650,6 +650,165 @@ func (m *CommunityPoolEthereumSpendProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolEthereumSpendProposal proto.InternalMessageInfo
 
+// ReleaseQuarantinedDepositsProposal credits quarantined deposits to their
+// cosmos receivers
+type ReleaseQuarantinedDepositsProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Ids         []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *ReleaseQuarantinedDepositsProposal) Reset()      { *m = ReleaseQuarantinedDepositsProposal{} }
+func (*ReleaseQuarantinedDepositsProposal) ProtoMessage() {}
+func (*ReleaseQuarantinedDepositsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{10}
+}
+func (m *ReleaseQuarantinedDepositsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseQuarantinedDepositsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseQuarantinedDepositsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseQuarantinedDepositsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseQuarantinedDepositsProposal.Merge(m, src)
+}
+func (m *ReleaseQuarantinedDepositsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseQuarantinedDepositsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseQuarantinedDepositsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseQuarantinedDepositsProposal proto.InternalMessageInfo
+
+// ReturnQuarantinedDepositsProposal sends quarantined deposits back to their
+// ethereum senders
+type ReturnQuarantinedDepositsProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Ids         []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *ReturnQuarantinedDepositsProposal) Reset()      { *m = ReturnQuarantinedDepositsProposal{} }
+func (*ReturnQuarantinedDepositsProposal) ProtoMessage() {}
+func (*ReturnQuarantinedDepositsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{11}
+}
+func (m *ReturnQuarantinedDepositsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReturnQuarantinedDepositsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReturnQuarantinedDepositsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReturnQuarantinedDepositsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReturnQuarantinedDepositsProposal.Merge(m, src)
+}
+func (m *ReturnQuarantinedDepositsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReturnQuarantinedDepositsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReturnQuarantinedDepositsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReturnQuarantinedDepositsProposal proto.InternalMessageInfo
+
+// AddEthereumDenylistProposal denies withdrawals to ethereum addresses
+type AddEthereumDenylistProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Addresses   []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *AddEthereumDenylistProposal) Reset()      { *m = AddEthereumDenylistProposal{} }
+func (*AddEthereumDenylistProposal) ProtoMessage() {}
+func (*AddEthereumDenylistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{12}
+}
+func (m *AddEthereumDenylistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddEthereumDenylistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddEthereumDenylistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddEthereumDenylistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddEthereumDenylistProposal.Merge(m, src)
+}
+func (m *AddEthereumDenylistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddEthereumDenylistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddEthereumDenylistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddEthereumDenylistProposal proto.InternalMessageInfo
+
+// RemoveEthereumDenylistProposal allows withdrawals to denylisted ethereum
+// addresses again
+type RemoveEthereumDenylistProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Addresses   []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *RemoveEthereumDenylistProposal) Reset()      { *m = RemoveEthereumDenylistProposal{} }
+func (*RemoveEthereumDenylistProposal) ProtoMessage() {}
+func (*RemoveEthereumDenylistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{13}
+}
+func (m *RemoveEthereumDenylistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveEthereumDenylistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveEthereumDenylistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveEthereumDenylistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveEthereumDenylistProposal.Merge(m, src)
+}
+func (m *RemoveEthereumDenylistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveEthereumDenylistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveEthereumDenylistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveEthereumDenylistProposal proto.InternalMessageInfo
+
 // This format of the community spend Ethereum proposal is specifically for
 // the CLI to allow simple text serialization.
 type CommunityPoolEthereumSpendProposalForCLI struct {
@@ -667,7 +826,7 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Reset() {
 func (m *CommunityPoolEthereumSpendProposalForCLI) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolEthereumSpendProposalForCLI) ProtoMessage()    {}
 func (*CommunityPoolEthereumSpendProposalForCLI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{10}
+	return fileDescriptor_1715a041eadeb531, []int{14}
 }
 func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,79 +866,88 @@ func init() {
 	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
 	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
 	proto.RegisterType((*CommunityPoolEthereumSpendProposal)(nil), "gravity.v1.CommunityPoolEthereumSpendProposal")
+	proto.RegisterType((*ReleaseQuarantinedDepositsProposal)(nil), "gravity.v1.ReleaseQuarantinedDepositsProposal")
+	proto.RegisterType((*ReturnQuarantinedDepositsProposal)(nil), "gravity.v1.ReturnQuarantinedDepositsProposal")
+	proto.RegisterType((*AddEthereumDenylistProposal)(nil), "gravity.v1.AddEthereumDenylistProposal")
+	proto.RegisterType((*RemoveEthereumDenylistProposal)(nil), "gravity.v1.RemoveEthereumDenylistProposal")
 	proto.RegisterType((*CommunityPoolEthereumSpendProposalForCLI)(nil), "gravity.v1.CommunityPoolEthereumSpendProposalForCLI")
 }
 
 func init() { proto.RegisterFile("gravity/v1/gravity.proto", fileDescriptor_1715a041eadeb531) }
 
 var fileDescriptor_1715a041eadeb531 = []byte{
-	// 1048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x36, 0x6d, 0x26, 0x69, 0xb6, 0x1d, 0x4a, 0x71, 0xab, 0x55, 0x1c, 0x19, 0xb1,
-	0x64, 0x25, 0x6a, 0xb7, 0x61, 0x25, 0xa0, 0x88, 0x95, 0xd6, 0x61, 0xab, 0xad, 0xb4, 0x42, 0x8b,
-	0x5b, 0x38, 0x70, 0xa9, 0x1c, 0xfb, 0xd5, 0x35, 0x75, 0x3c, 0x96, 0x3d, 0x09, 0xcd, 0x91, 0x0b,
-	0xe2, 0xc8, 0x05, 0x89, 0x63, 0xcf, 0x9c, 0x39, 0x72, 0xe3, 0xb2, 0xe2, 0xb4, 0x47, 0xe0, 0x10,
-	0xa0, 0xbd, 0x70, 0xce, 0x5f, 0x80, 0x3c, 0x3f, 0x5c, 0x7b, 0xb7, 0xa2, 0x9c, 0x3a, 0xef, 0xbd,
-	0xef, 0x7b, 0xf3, 0xe6, 0x9b, 0xaf, 0x9e, 0x20, 0xd5, 0x4f, 0x9c, 0x49, 0x40, 0xa7, 0xe6, 0x64,
-	0xd7, 0x14, 0x4b, 0x23, 0x4e, 0x08, 0x25, 0x18, 0xc9, 0x70, 0xb2, 0xbb, 0xd5, 0x71, 0x49, 0x3a,
-	0x22, 0xa9, 0x39, 0x74, 0x52, 0x30, 0x27, 0xbb, 0x43, 0xa0, 0xce, 0xae, 0xe9, 0x92, 0x20, 0xe2,
-	0xd8, 0xad, 0x4d, 0x5e, 0x3f, 0x66, 0x91, 0xc9, 0x03, 0x51, 0x5a, 0xf7, 0x89, 0x4f, 0x78, 0x3e,
-	0x5b, 0x49, 0x82, 0x4f, 0x88, 0x1f, 0x82, 0xc9, 0xa2, 0xe1, 0xf8, 0xc4, 0x74, 0x22, 0xb1, 0xaf,
-	0xfe, 0xbd, 0x82, 0xde, 0x78, 0x4c, 0x4f, 0x21, 0x81, 0xf1, 0xe8, 0xf1, 0x04, 0x22, 0xfa, 0x39,
-	0xa1, 0x60, 0x83, 0x4b, 0x12, 0x0f, 0x3f, 0x41, 0x8b, 0x90, 0xa5, 0x54, 0xa5, 0xab, 0xf4, 0x9a,
-	0xfd, 0x75, 0x83, 0xb7, 0x31, 0x64, 0x1b, 0xe3, 0x51, 0x34, 0xb5, 0xee, 0xfe, 0xfa, 0xd3, 0xb6,
-	0x7a, 0x3d, 0xbc, 0x51, 0x6a, 0x66, 0xf3, 0x06, 0x78, 0x1d, 0x2d, 0x4e, 0x08, 0x85, 0x54, 0xad,
-	0x76, 0x6b, 0xbd, 0x86, 0xcd, 0x03, 0xbc, 0x85, 0x96, 0x1d, 0xd7, 0x85, 0x98, 0x82, 0xa7, 0xd6,
-	0xba, 0x4a, 0x6f, 0xd9, 0xce, 0x63, 0x3d, 0x40, 0x9b, 0x4f, 0x1d, 0x0a, 0x29, 0x95, 0xfd, 0xac,
-	0x90, 0xb8, 0x67, 0x4f, 0x20, 0xf0, 0x4f, 0x29, 0x7e, 0x1b, 0xdd, 0x01, 0x91, 0x3e, 0x3e, 0x65,
-	0x29, 0x36, 0xe2, 0x82, 0xdd, 0x96, 0x69, 0x01, 0x7c, 0x13, 0xad, 0x08, 0xad, 0x04, 0xac, 0xca,
-	0x60, 0x2d, 0x9e, 0xe4, 0x20, 0xfd, 0x53, 0xd4, 0x96, 0x9b, 0x1c, 0x06, 0x7e, 0x04, 0x49, 0x36,
-	0x6e, 0x4c, 0xbe, 0x82, 0x44, 0x74, 0xe5, 0x01, 0xbe, 0x8f, 0x56, 0xf3, 0x5d, 0x1d, 0xcf, 0x4b,
-	0x20, 0x4d, 0x59, 0xbf, 0x86, 0x9d, 0x4f, 0xf3, 0x88, 0xa7, 0xf5, 0x6f, 0x14, 0xd4, 0xe4, 0xbd,
-	0x0e, 0x81, 0x1e, 0x9d, 0x67, 0x0d, 0x23, 0x12, 0xb9, 0x20, 0x1b, 0xb2, 0x00, 0x6f, 0xa0, 0x7a,
-	0x69, 0x2c, 0x11, 0xe1, 0x03, 0xb4, 0x94, 0x32, 0x72, 0xaa, 0xd6, 0xba, 0xb5, 0x5e, 0xb3, 0xbf,
-	0x65, 0xdc, 0x20, 0x30, 0xef, 0x6f, 0xbd, 0xf6, 0xe3, 0x9f, 0xda, 0x9d, 0x72, 0x2e, 0xb5, 0x25,
-	0x5f, 0xff, 0x45, 0x41, 0x4b, 0x96, 0x43, 0xdd, 0xd3, 0xa3, 0x73, 0xac, 0xa1, 0xe6, 0x30, 0x5b,
-	0x1e, 0x17, 0x47, 0x41, 0x2c, 0xf5, 0x09, 0x9b, 0x47, 0x45, 0x4b, 0x34, 0x18, 0x01, 0x19, 0xcb,
-	0x81, 0x64, 0x88, 0x1f, 0xa2, 0x16, 0x4d, 0x9c, 0x28, 0x75, 0x5c, 0x1a, 0x90, 0xe8, 0xc6, 0xb1,
-	0x0e, 0x21, 0xf2, 0x8e, 0x88, 0x1c, 0xc4, 0x2e, 0xe1, 0xf1, 0x5b, 0xa8, 0x4d, 0xc9, 0x19, 0x44,
-	0xc7, 0x2e, 0x89, 0x68, 0xe2, 0xb8, 0x54, 0x5d, 0x60, 0xc2, 0xad, 0xb0, 0xec, 0x40, 0x24, 0x0b,
-	0x82, 0x2c, 0x16, 0x05, 0xd1, 0xff, 0x56, 0x50, 0xbb, 0xdc, 0x1f, 0xb7, 0x51, 0x35, 0xf0, 0xc4,
-	0x19, 0xaa, 0x81, 0x97, 0x51, 0x53, 0x88, 0x3c, 0x48, 0xc4, 0x95, 0x88, 0x08, 0x6f, 0x23, 0x9c,
-	0x5f, 0x5a, 0x02, 0x6e, 0x10, 0x07, 0x99, 0xa1, 0x6b, 0x0c, 0xb3, 0x26, 0x2b, 0xb6, 0x2c, 0xe0,
-	0x8f, 0x50, 0x13, 0x12, 0xb7, 0xbf, 0x73, 0xcc, 0x06, 0x63, 0x53, 0x36, 0xfb, 0x1b, 0x25, 0xf9,
-	0xed, 0x41, 0x7f, 0xe7, 0x28, 0xab, 0x5a, 0x0b, 0xcf, 0x67, 0x5a, 0xc5, 0x46, 0x8c, 0xc0, 0x32,
-	0xf8, 0x03, 0xd4, 0xe0, 0xf4, 0x13, 0x00, 0x76, 0x86, 0xdb, 0xc8, 0xcb, 0x0c, 0xbe, 0x0f, 0xa0,
-	0xff, 0x5c, 0x45, 0x6d, 0x29, 0xc4, 0xc0, 0x09, 0xc3, 0xa3, 0xf3, 0x6c, 0xf6, 0x20, 0x9a, 0x38,
-	0x61, 0xe0, 0x39, 0x99, 0x8c, 0xa5, 0x7b, 0x5b, 0x2b, 0x56, 0xf8, 0xf5, 0xbd, 0x0c, 0x4f, 0x5d,
-	0x12, 0x03, 0x93, 0xa3, 0x55, 0x86, 0x1f, 0x66, 0x85, 0xec, 0xb6, 0xa5, 0x8b, 0xb9, 0x1c, 0x32,
-	0xcc, 0x2a, 0xb1, 0x33, 0x0d, 0x89, 0xe3, 0x31, 0x01, 0x5a, 0xb6, 0x0c, 0x8b, 0x0e, 0x59, 0x2c,
-	0x3b, 0xe4, 0x01, 0xaa, 0x33, 0xc9, 0x52, 0xb5, 0xce, 0xbc, 0xf1, 0xdf, 0xc7, 0x16, 0x58, 0xbc,
-	0x83, 0x16, 0x4e, 0x00, 0x52, 0x75, 0xe9, 0x7f, 0x70, 0x18, 0xb2, 0x60, 0x91, 0xe5, 0x92, 0x45,
-	0x62, 0x84, 0xae, 0x19, 0xd9, 0x97, 0x25, 0x77, 0x9a, 0xc2, 0x0e, 0x97, 0xc7, 0x78, 0x1f, 0xd5,
-	0x9d, 0x11, 0x19, 0x47, 0xdc, 0xe4, 0x0d, 0xcb, 0xc8, 0xba, 0xff, 0x31, 0xd3, 0xee, 0xf9, 0x01,
-	0x3d, 0x1d, 0x0f, 0x0d, 0x97, 0x8c, 0xc4, 0x37, 0x55, 0xfc, 0xd9, 0x4e, 0xbd, 0x33, 0x93, 0x4e,
-	0x63, 0x48, 0x8d, 0x83, 0x88, 0xda, 0x82, 0xad, 0x6f, 0xa2, 0xc5, 0x83, 0x8f, 0x0f, 0x81, 0xe2,
-	0x55, 0x54, 0x0b, 0xbc, 0x54, 0x55, 0xba, 0xb5, 0xde, 0x82, 0x9d, 0x2d, 0xf5, 0xaf, 0xab, 0x48,
-	0x1f, 0x90, 0xd1, 0x68, 0x1c, 0x05, 0x74, 0xfa, 0x8c, 0x90, 0x30, 0xff, 0xff, 0x8c, 0x21, 0xf2,
-	0x9e, 0x25, 0x24, 0x26, 0xa9, 0x13, 0x66, 0x5f, 0x05, 0x1a, 0xd0, 0x10, 0xc4, 0x88, 0x3c, 0xc0,
-	0x5d, 0xd4, 0xf4, 0x20, 0x75, 0x93, 0x20, 0xce, 0xee, 0x4a, 0xd8, 0xb9, 0x98, 0xc2, 0x77, 0x51,
-	0xe3, 0x65, 0x2b, 0x5f, 0x27, 0xf0, 0x7b, 0xf9, 0xf9, 0xb8, 0x7b, 0x37, 0x0d, 0xf1, 0x42, 0x64,
-	0xcf, 0x89, 0x21, 0x9e, 0x13, 0x63, 0x40, 0x82, 0xfc, 0x32, 0x38, 0x1c, 0x3f, 0x44, 0x68, 0x98,
-	0x04, 0x9e, 0x0f, 0x05, 0xf7, 0xde, 0x4a, 0x6e, 0x70, 0xca, 0x3e, 0xc0, 0x5e, 0xeb, 0xdb, 0x0b,
-	0xad, 0xf2, 0xc3, 0x85, 0x56, 0xf9, 0xe7, 0x42, 0xab, 0xe8, 0xbf, 0x57, 0x51, 0xef, 0x76, 0x0d,
-	0xf6, 0x49, 0x32, 0x78, 0x7a, 0x80, 0xef, 0x95, 0x94, 0xb0, 0x56, 0xe7, 0x33, 0xad, 0x35, 0x75,
-	0x46, 0xe1, 0x9e, 0xce, 0xd2, 0xba, 0xd4, 0xe6, 0xfd, 0x1b, 0xb4, 0xb1, 0x36, 0xe6, 0x33, 0x0d,
-	0x73, 0x74, 0xa1, 0xa8, 0x97, 0x35, 0xeb, 0xbf, 0xa2, 0x99, 0xb5, 0x3e, 0x9f, 0x69, 0xab, 0x9c,
-	0x97, 0x97, 0xf4, 0xa2, 0x92, 0xf7, 0x4b, 0x4a, 0x36, 0xac, 0xb5, 0xf9, 0x4c, 0x5b, 0xe1, 0x04,
-	0xe1, 0x81, 0x5c, 0xbb, 0x07, 0xaf, 0x68, 0xd7, 0xb0, 0x5e, 0x9f, 0xcf, 0xb4, 0x35, 0x0e, 0xbf,
-	0xae, 0xe9, 0x05, 0xc5, 0xf0, 0x3b, 0x68, 0xc9, 0x83, 0x98, 0xa4, 0x01, 0x55, 0xeb, 0x8c, 0x82,
-	0xe7, 0x33, 0xad, 0x2d, 0x8f, 0xc2, 0x0a, 0xba, 0x2d, 0x21, 0x7b, 0xcb, 0x42, 0x5f, 0xc5, 0xfa,
-	0xec, 0xf9, 0x65, 0x47, 0x79, 0x71, 0xd9, 0x51, 0xfe, 0xba, 0xec, 0x28, 0xdf, 0x5d, 0x75, 0x2a,
-	0x2f, 0xae, 0x3a, 0x95, 0xdf, 0xae, 0x3a, 0x95, 0x2f, 0x3e, 0x2c, 0x98, 0x38, 0x06, 0xdf, 0x9f,
-	0x7e, 0x39, 0x91, 0x3f, 0x34, 0xb6, 0xf9, 0xbe, 0xe6, 0x88, 0x78, 0xe3, 0x10, 0xcc, 0x49, 0xdf,
-	0x3c, 0x97, 0x25, 0xee, 0xee, 0x61, 0x9d, 0x3d, 0xec, 0xef, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0xf9, 0x63, 0x10, 0x41, 0xa6, 0x08, 0x00, 0x00,
+	// 1134 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xc1, 0x6f, 0xdb, 0x54,
+	0x18, 0x8f, 0x93, 0x26, 0x6d, 0x5e, 0xb2, 0xac, 0x7d, 0x94, 0xe1, 0x96, 0x29, 0x0e, 0x46, 0x8c,
+	0x4c, 0xa2, 0xf6, 0x1a, 0x26, 0x01, 0x43, 0x4c, 0x9a, 0xb3, 0x55, 0xab, 0x34, 0xa1, 0xcd, 0x2d,
+	0x1c, 0xb8, 0x54, 0x8e, 0xfd, 0x2d, 0x35, 0x75, 0xfc, 0x2c, 0xbf, 0x97, 0xd0, 0x08, 0x2e, 0x93,
+	0x10, 0xe2, 0xc8, 0x05, 0x89, 0x63, 0xcf, 0x9c, 0x39, 0x72, 0xe3, 0x32, 0x71, 0xda, 0x11, 0x38,
+	0x04, 0x68, 0x2f, 0x9c, 0xf3, 0x17, 0x20, 0xbf, 0xf7, 0xec, 0xda, 0x5d, 0xa5, 0x4e, 0x02, 0x69,
+	0xa7, 0xf8, 0xfb, 0xbe, 0xdf, 0xf7, 0xbd, 0x9f, 0x7f, 0xdf, 0xf7, 0x3d, 0x07, 0xa9, 0xc3, 0xd8,
+	0x99, 0xf8, 0x6c, 0x6a, 0x4e, 0x36, 0x4d, 0xf9, 0x68, 0x44, 0x31, 0x61, 0x04, 0xa3, 0xd4, 0x9c,
+	0x6c, 0xae, 0xb7, 0x5d, 0x42, 0x47, 0x84, 0x9a, 0x03, 0x87, 0x82, 0x39, 0xd9, 0x1c, 0x00, 0x73,
+	0x36, 0x4d, 0x97, 0xf8, 0xa1, 0xc0, 0xae, 0xaf, 0x89, 0xf8, 0x1e, 0xb7, 0x4c, 0x61, 0xc8, 0xd0,
+	0xea, 0x90, 0x0c, 0x89, 0xf0, 0x27, 0x4f, 0x69, 0xc2, 0x90, 0x90, 0x61, 0x00, 0x26, 0xb7, 0x06,
+	0xe3, 0xc7, 0xa6, 0x13, 0xca, 0x73, 0xf5, 0xef, 0x15, 0xf4, 0xda, 0x3d, 0xb6, 0x0f, 0x31, 0x8c,
+	0x47, 0xf7, 0x26, 0x10, 0xb2, 0x4f, 0x09, 0x03, 0x1b, 0x5c, 0x12, 0x7b, 0xf8, 0x3e, 0xaa, 0x42,
+	0xe2, 0x52, 0x95, 0x8e, 0xd2, 0x6d, 0xf4, 0x56, 0x0d, 0x51, 0xc6, 0x48, 0xcb, 0x18, 0x77, 0xc2,
+	0xa9, 0x75, 0xf5, 0xd7, 0x9f, 0x36, 0xd4, 0x53, 0xf2, 0x46, 0xa1, 0x98, 0x2d, 0x0a, 0xe0, 0x55,
+	0x54, 0x9d, 0x10, 0x06, 0x54, 0x2d, 0x77, 0x2a, 0xdd, 0xba, 0x2d, 0x0c, 0xbc, 0x8e, 0x96, 0x1c,
+	0xd7, 0x85, 0x88, 0x81, 0xa7, 0x56, 0x3a, 0x4a, 0x77, 0xc9, 0xce, 0x6c, 0xdd, 0x47, 0x6b, 0x0f,
+	0x1c, 0x06, 0x94, 0xa5, 0xf5, 0xac, 0x80, 0xb8, 0x07, 0xf7, 0xc1, 0x1f, 0xee, 0x33, 0xfc, 0x36,
+	0xba, 0x0c, 0xd2, 0xbd, 0xb7, 0xcf, 0x5d, 0x9c, 0xe2, 0x82, 0xdd, 0x4a, 0xdd, 0x12, 0xf8, 0x26,
+	0xba, 0x24, 0xb5, 0x92, 0xb0, 0x32, 0x87, 0x35, 0x85, 0x53, 0x80, 0xf4, 0x47, 0xa8, 0x95, 0x1e,
+	0xb2, 0xe3, 0x0f, 0x43, 0x88, 0x13, 0xba, 0x11, 0xf9, 0x02, 0x62, 0x59, 0x55, 0x18, 0xf8, 0x3a,
+	0x5a, 0xce, 0x4e, 0x75, 0x3c, 0x2f, 0x06, 0x4a, 0x79, 0xbd, 0xba, 0x9d, 0xb1, 0xb9, 0x23, 0xdc,
+	0xfa, 0x37, 0x0a, 0x6a, 0x88, 0x5a, 0x3b, 0xc0, 0x76, 0x0f, 0x93, 0x82, 0x21, 0x09, 0x5d, 0x48,
+	0x0b, 0x72, 0x03, 0x5f, 0x41, 0xb5, 0x02, 0x2d, 0x69, 0xe1, 0x6d, 0xb4, 0x48, 0x79, 0x32, 0x55,
+	0x2b, 0x9d, 0x4a, 0xb7, 0xd1, 0x5b, 0x37, 0xce, 0x11, 0x58, 0xd4, 0xb7, 0x5e, 0xf9, 0xf1, 0x4f,
+	0xed, 0x72, 0xd1, 0x47, 0xed, 0x34, 0x5f, 0xff, 0x45, 0x41, 0x8b, 0x96, 0xc3, 0xdc, 0xfd, 0xdd,
+	0x43, 0xac, 0xa1, 0xc6, 0x20, 0x79, 0xdc, 0xcb, 0x53, 0x41, 0xdc, 0xf5, 0x31, 0xe7, 0xa3, 0xa2,
+	0x45, 0xe6, 0x8f, 0x80, 0x8c, 0x53, 0x42, 0xa9, 0x89, 0x6f, 0xa3, 0x26, 0x8b, 0x9d, 0x90, 0x3a,
+	0x2e, 0xf3, 0x49, 0x78, 0x2e, 0xad, 0x1d, 0x08, 0xbd, 0x5d, 0x92, 0x12, 0xb1, 0x0b, 0x78, 0xfc,
+	0x16, 0x6a, 0x31, 0x72, 0x00, 0xe1, 0x9e, 0x4b, 0x42, 0x16, 0x3b, 0x2e, 0x53, 0x17, 0xb8, 0x70,
+	0x97, 0xb8, 0xb7, 0x2f, 0x9d, 0x39, 0x41, 0xaa, 0x79, 0x41, 0xf4, 0xbf, 0x15, 0xd4, 0x2a, 0xd6,
+	0xc7, 0x2d, 0x54, 0xf6, 0x3d, 0xf9, 0x0e, 0x65, 0xdf, 0x4b, 0x52, 0x29, 0x84, 0x1e, 0xc4, 0xb2,
+	0x25, 0xd2, 0xc2, 0x1b, 0x08, 0x67, 0x4d, 0x8b, 0xc1, 0xf5, 0x23, 0x3f, 0x19, 0xe8, 0x0a, 0xc7,
+	0xac, 0xa4, 0x11, 0x3b, 0x0d, 0xe0, 0x8f, 0x50, 0x03, 0x62, 0xb7, 0x77, 0x63, 0x8f, 0x13, 0xe3,
+	0x2c, 0x1b, 0xbd, 0x2b, 0x05, 0xf9, 0xed, 0x7e, 0xef, 0xc6, 0x6e, 0x12, 0xb5, 0x16, 0x9e, 0xce,
+	0xb4, 0x92, 0x8d, 0x78, 0x02, 0xf7, 0xe0, 0x0f, 0x50, 0x5d, 0xa4, 0x3f, 0x06, 0x50, 0xab, 0x2f,
+	0x90, 0xbc, 0xc4, 0xe1, 0x5b, 0x00, 0xfa, 0xcf, 0x65, 0xd4, 0x4a, 0x85, 0xe8, 0x3b, 0x41, 0xb0,
+	0x7b, 0x98, 0x70, 0xf7, 0xc3, 0x89, 0x13, 0xf8, 0x9e, 0x93, 0xc8, 0x58, 0xe8, 0xdb, 0x4a, 0x3e,
+	0x22, 0xda, 0x77, 0x16, 0x4e, 0x5d, 0x12, 0x01, 0x97, 0xa3, 0x59, 0x84, 0xef, 0x24, 0x81, 0xa4,
+	0xdb, 0xe9, 0x14, 0x0b, 0x39, 0x52, 0x33, 0x89, 0x44, 0xce, 0x34, 0x20, 0x8e, 0xc7, 0x05, 0x68,
+	0xda, 0xa9, 0x99, 0x9f, 0x90, 0x6a, 0x71, 0x42, 0x6e, 0xa2, 0x1a, 0x97, 0x8c, 0xaa, 0xb5, 0x4e,
+	0xe5, 0xc2, 0xd7, 0x96, 0x58, 0x7c, 0x03, 0x2d, 0x3c, 0x06, 0xa0, 0xea, 0xe2, 0x0b, 0xe4, 0x70,
+	0x64, 0x6e, 0x44, 0x96, 0x0a, 0x23, 0x12, 0x21, 0x74, 0x9a, 0x91, 0xdc, 0x2c, 0xd9, 0xa4, 0x29,
+	0xfc, 0xe5, 0x32, 0x1b, 0x6f, 0xa1, 0x9a, 0x33, 0x22, 0xe3, 0x50, 0x0c, 0x79, 0xdd, 0x32, 0x92,
+	0xea, 0x7f, 0xcc, 0xb4, 0x6b, 0x43, 0x9f, 0xed, 0x8f, 0x07, 0x86, 0x4b, 0x46, 0xf2, 0x4e, 0x95,
+	0x3f, 0x1b, 0xd4, 0x3b, 0x30, 0xd9, 0x34, 0x02, 0x6a, 0x6c, 0x87, 0xcc, 0x96, 0xd9, 0xfa, 0x1a,
+	0xaa, 0x6e, 0xdf, 0xdd, 0x01, 0x86, 0x97, 0x51, 0xc5, 0xf7, 0xa8, 0xaa, 0x74, 0x2a, 0xdd, 0x05,
+	0x3b, 0x79, 0xd4, 0x9f, 0x94, 0x91, 0xde, 0x27, 0xa3, 0xd1, 0x38, 0xf4, 0xd9, 0xf4, 0x21, 0x21,
+	0x41, 0xb6, 0x9f, 0x11, 0x84, 0xde, 0xc3, 0x98, 0x44, 0x84, 0x3a, 0x41, 0x72, 0x2b, 0x30, 0x9f,
+	0x05, 0x20, 0x29, 0x0a, 0x03, 0x77, 0x50, 0xc3, 0x03, 0xea, 0xc6, 0x7e, 0x94, 0xf4, 0x4a, 0x8e,
+	0x73, 0xde, 0x85, 0xaf, 0xa2, 0xfa, 0xd9, 0x51, 0x3e, 0x75, 0xe0, 0xf7, 0xb2, 0xf7, 0x13, 0xd3,
+	0xbb, 0x66, 0xc8, 0x2f, 0x44, 0xf2, 0x39, 0x31, 0xe4, 0xe7, 0xc4, 0xe8, 0x13, 0x3f, 0x6b, 0x86,
+	0x80, 0xe3, 0xdb, 0x08, 0x0d, 0x62, 0xdf, 0x1b, 0x42, 0x6e, 0x7a, 0x2f, 0x4c, 0xae, 0x8b, 0x94,
+	0x2d, 0x80, 0x5b, 0xcd, 0x6f, 0x8f, 0xb4, 0xd2, 0x0f, 0x47, 0x5a, 0xe9, 0x9f, 0x23, 0xad, 0xa4,
+	0x7f, 0x85, 0x74, 0x1b, 0x02, 0x70, 0x28, 0x3c, 0x1a, 0x3b, 0xb1, 0x13, 0x32, 0x3f, 0x04, 0xef,
+	0x2e, 0x44, 0x84, 0xfa, 0x8c, 0xfe, 0x67, 0x09, 0xa4, 0xe6, 0x95, 0x4c, 0xf3, 0x33, 0xa7, 0x7f,
+	0x89, 0xde, 0xb0, 0x81, 0x8d, 0xe3, 0xf0, 0x65, 0x1c, 0xfe, 0x44, 0x41, 0xaf, 0xdf, 0xf1, 0xbc,
+	0xb4, 0xe9, 0x77, 0x21, 0x9c, 0x06, 0x3e, 0x65, 0xff, 0x47, 0xdf, 0xe5, 0x8a, 0x82, 0x38, 0xbd,
+	0x6e, 0x9f, 0x3a, 0xce, 0x70, 0xf8, 0x5a, 0x41, 0x6d, 0x1b, 0x46, 0x64, 0x02, 0x2f, 0x95, 0xc6,
+	0xef, 0x65, 0xd4, 0xbd, 0x78, 0x13, 0xb6, 0x48, 0xdc, 0x7f, 0xb0, 0x8d, 0xaf, 0x15, 0x08, 0x59,
+	0xcb, 0xf3, 0x99, 0xd6, 0x9c, 0x3a, 0xa3, 0xe0, 0x96, 0xce, 0xdd, 0x7a, 0x4a, 0xf1, 0xfd, 0x73,
+	0x28, 0x5a, 0x57, 0xe6, 0x33, 0x0d, 0x0b, 0x74, 0x2e, 0xa8, 0x17, 0xa9, 0xf7, 0x9e, 0xdb, 0x1c,
+	0x6b, 0x75, 0x3e, 0xd3, 0x96, 0x45, 0x5e, 0x16, 0xd2, 0xf3, 0xfb, 0x74, 0xbd, 0xb0, 0x4f, 0x75,
+	0x6b, 0x65, 0x3e, 0xd3, 0x2e, 0x89, 0x04, 0x79, 0x13, 0x64, 0x1b, 0x74, 0xf3, 0xb9, 0x0d, 0xaa,
+	0x5b, 0xaf, 0xce, 0x67, 0xda, 0x8a, 0x80, 0x9f, 0xc6, 0xf4, 0xdc, 0xde, 0xe0, 0x77, 0xd0, 0xa2,
+	0x27, 0x46, 0x53, 0xad, 0xf1, 0x14, 0x3c, 0x9f, 0x69, 0xad, 0xf4, 0x55, 0x78, 0x40, 0xb7, 0x53,
+	0xc8, 0xad, 0x25, 0xa9, 0xaf, 0x62, 0x7d, 0xf2, 0xf4, 0xb8, 0xad, 0x3c, 0x3b, 0x6e, 0x2b, 0x7f,
+	0x1d, 0xb7, 0x95, 0xef, 0x4e, 0xda, 0xa5, 0x67, 0x27, 0xed, 0xd2, 0x6f, 0x27, 0xed, 0xd2, 0x67,
+	0x1f, 0xe6, 0xae, 0xb2, 0x08, 0x86, 0xc3, 0xe9, 0xe7, 0x93, 0xf4, 0xef, 0xe6, 0x86, 0x38, 0xd7,
+	0x1c, 0x11, 0x6f, 0x1c, 0x80, 0x39, 0xe9, 0x99, 0x87, 0x69, 0x48, 0xdc, 0x71, 0x83, 0x1a, 0xff,
+	0x7b, 0xf7, 0xee, 0xbf, 0x03, 0x00, 0xe1, 0x5b, 0xf0, 0xa5, 0xac, 0x0a, 0x00, 0x00,
 }
 
 func (m *EthereumEventVoteRecord) Marshal() (dAtA []byte, err error) {
@@ -1304,6 +1472,208 @@ func (m *CommunityPoolEthereumSpendProposal) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *ReleaseQuarantinedDepositsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseQuarantinedDepositsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseQuarantinedDepositsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA9 := make([]byte, len(m.Ids)*10)
+		var j8 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintGravity(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReturnQuarantinedDepositsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReturnQuarantinedDepositsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReturnQuarantinedDepositsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA11 := make([]byte, len(m.Ids)*10)
+		var j10 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintGravity(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddEthereumDenylistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddEthereumDenylistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddEthereumDenylistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveEthereumDenylistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveEthereumDenylistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveEthereumDenylistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommunityPoolEthereumSpendProposalForCLI) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1604,7 +1974,7 @@ func (m *CommunityPoolEthereumSpendProposal) Size() (n int) {
 	return n
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) Size() (n int) {
+func (m *ReleaseQuarantinedDepositsProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1618,13 +1988,107 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGravity(uint64(l))
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ReturnQuarantinedDepositsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *AddEthereumDenylistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveEthereumDenylistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
 	}
 	l = len(m.BridgeFee)
 	if l > 0 {
@@ -3193,6 +3657,678 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReleaseQuarantinedDepositsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseQuarantinedDepositsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseQuarantinedDepositsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReturnQuarantinedDepositsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReturnQuarantinedDepositsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReturnQuarantinedDepositsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddEthereumDenylistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddEthereumDenylistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddEthereumDenylistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveEthereumDenylistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveEthereumDenylistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveEthereumDenylistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,10 +2,12 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -59,6 +61,109 @@ const (
 
 	// EthereumHeightVoteKey indexes the latest heights observed by each validator
 	EthereumHeightVoteKey
+
+	// SendToEthereumHeightKey indexes the block height at which a send to ethereum entered the pool
+	SendToEthereumHeightKey
+
+	// SendToEthereumPoolHeightKey indexes the unbatched send to ethereums by the height they entered the pool
+	SendToEthereumPoolHeightKey
+
+	// SendToEthereumBatchTimeoutsKey indexes the number of timed out batches a send to ethereum was part of
+	SendToEthereumBatchTimeoutsKey
+
+	// OutflowKey indexes the amount of each token batched for Ethereum by block height
+	OutflowKey
+
+	// OutflowPausedKey indexes the height until which new send to ethereums of a token are paused
+	OutflowPausedKey
+
+	// InflowKey indexes the amount of each token deposited from Ethereum by block height
+	InflowKey
+
+	// QuarantinedDepositKey indexes the deposits held in quarantine by id
+	QuarantinedDepositKey
+
+	// LastQuarantinedDepositIDKey indexes the id of the last quarantined deposit
+	LastQuarantinedDepositIDKey
+
+	// EthereumDenylistKey indexes the ethereum addresses governance denied withdrawals to
+	EthereumDenylistKey
+
+	// SendToEthereumByIDKey indexes the unbatched send to ethereums by id
+	SendToEthereumByIDKey
+
+	// SendToEthereumBySenderKey indexes the unbatched send to ethereums by sender
+	SendToEthereumBySenderKey
+
+	// SendToEthereumByRecipientKey indexes the unbatched send to ethereums by ethereum recipient
+	SendToEthereumByRecipientKey
+
+	// SendToEthereumStatusKey indexes the lifecycle status of each send to ethereum by id
+	SendToEthereumStatusKey
+
+	// SendToEthereumStatusPruneKey indexes the finished send to ethereum statuses by the height they finished at
+	SendToEthereumStatusPruneKey
+
+	// SendToEthereumByContractKey indexes the unbatched send to ethereums by token contract and id
+	SendToEthereumByContractKey
+
+	// ExecutedBatchStatsKey indexes the stats of the recently executed batches by token contract and batch nonce
+	ExecutedBatchStatsKey
+
+	// OutgoingBatchTxByTokenKey indexes the outgoing batch txs by token contract and batch nonce
+	OutgoingBatchTxByTokenKey
+
+	// OutgoingContractCallTxByScopeKey indexes the outgoing contract call txs by invalidation scope and nonce
+	OutgoingContractCallTxByScopeKey
+
+	// OutgoingTxTimeoutKey indexes the outgoing batch and contract call txs by their timeout height on ethereum
+	OutgoingTxTimeoutKey
+
+	// OutgoingTxByHeightKey indexes the outgoing txs by the block height they were created at
+	OutgoingTxByHeightKey
+
+	// LastSignerSetChangeBlockHeightKey indexes the last block height a bonded validator changed its
+	// ethereum address or was jailed
+	LastSignerSetChangeBlockHeightKey
+
+	// SignerSetHashKey indexes the hash of the signers of every signer set tx created by nonce
+	SignerSetHashKey
+
+	// SignerSetHijackIncidentKey indexes the observed signer sets that did not match the created ones by nonce
+	SignerSetHijackIncidentKey
+
+	// EthereumSignatureCheckpointKey indexes the checkpoints of every outgoing tx created
+	EthereumSignatureCheckpointKey
+
+	// BadSignatureEvidenceKey indexes the submitted bad signature evidence by checkpoint and ethereum signer
+	BadSignatureEvidenceKey
+
+	// BadSignatureEvidenceFloorKey indexes the latest nonces issued before outgoing tx checkpoints were kept
+	BadSignatureEvidenceFloorKey
+
+	// ConflictingEventVoteKey indexes the conflicting event votes of each validator by event nonce
+	ConflictingEventVoteKey
+
+	// EthereumEventAcceptedHeightKey indexes the block height each event nonce was accepted at
+	EthereumEventAcceptedHeightKey
+
+	// EthereumEventExcusedNonceKey indexes the event nonce up to which missed votes are not held against each validator
+	EthereumEventExcusedNonceKey
+
+	// LastBridgeInactiveHeightKey indexes the last block height the bridge was disabled at
+	LastBridgeInactiveHeightKey
+
+	// BridgeSigningInfoKey indexes the bridge signing info of each validator
+	BridgeSigningInfoKey
+
+	// LaggingOracleValidatorKey indexes the validators an oracle_lagging event was emitted for until they catch up
+	LaggingOracleValidatorKey
+
+	// ReturnedQuarantinedDepositKey indexes the quarantined deposit each send to ethereum returns, while it is in the bridge
+	ReturnedQuarantinedDepositKey
+
+	// UntrackedEthereumEventAcceptedHeightKey indexes the block height assumed for the event nonces accepted before their heights were kept
+	UntrackedEthereumEventAcceptedHeightKey
 )
 
 ////////////////////
@@ -126,6 +231,251 @@ func MakeSendToEthereumKey(id uint64, fee ERC20Token) []byte {
 	return bytes.Join([][]byte{{SendToEthereumKey}, common.HexToAddress(fee.Contract).Bytes(), fee.Amount.BigInt().FillBytes(amount), sdk.Uint64ToBigEndian(id)}, []byte{})
 }
 
+// MakeSendToEthereumHeightKey returns the following key format
+// prefix          id
+// [0x15][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumHeightKey(id uint64) []byte {
+	return append([]byte{SendToEthereumHeightKey}, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeSendToEthereumPoolHeightKey returns the following key format
+// prefix          height              id
+// [0x16][0 0 0 0 0 0 0 100][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumPoolHeightKey(height, id uint64) []byte {
+	return bytes.Join([][]byte{{SendToEthereumPoolHeightKey}, sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(id)}, []byte{})
+}
+
+// MakeSendToEthereumBatchTimeoutsKey returns the following key format
+// prefix          id
+// [0x17][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumBatchTimeoutsKey(id uint64) []byte {
+	return append([]byte{SendToEthereumBatchTimeoutsKey}, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeSendToEthereumByIDKey returns the following key format
+// prefix          id
+// [0x1e][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumByIDKey(id uint64) []byte {
+	return append([]byte{SendToEthereumByIDKey}, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeSendToEthereumBySenderKey returns the following key format
+// prefix   length                  sender                          id
+// [0x1f][20][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumBySenderKey(sender sdk.AccAddress, id uint64) []byte {
+	return append(MakeSendToEthereumBySenderPrefix(sender), sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeSendToEthereumBySenderPrefix returns the prefix of the unbatched send to ethereums of a sender
+func MakeSendToEthereumBySenderPrefix(sender sdk.AccAddress) []byte {
+	return append([]byte{SendToEthereumBySenderKey}, address.MustLengthPrefix(sender)...)
+}
+
+// MakeSendToEthereumByRecipientKey returns the following key format
+// prefix              ethereum recipient                           id
+// [0x20][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumByRecipientKey(recipient common.Address, id uint64) []byte {
+	return append(MakeSendToEthereumByRecipientPrefix(recipient), sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeSendToEthereumByRecipientPrefix returns the prefix of the unbatched send to ethereums to a recipient
+func MakeSendToEthereumByRecipientPrefix(recipient common.Address) []byte {
+	return append([]byte{SendToEthereumByRecipientKey}, recipient.Bytes()...)
+}
+
+// MakeSendToEthereumByContractKey returns the following key format
+// prefix              token contract                           id
+// [0x23][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumByContractKey(tokenContract common.Address, id uint64) []byte {
+	return append(MakeSendToEthereumByContractPrefix(tokenContract), sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeSendToEthereumByContractPrefix returns the prefix of the unbatched send to ethereums of a token
+func MakeSendToEthereumByContractPrefix(tokenContract common.Address) []byte {
+	return append([]byte{SendToEthereumByContractKey}, tokenContract.Bytes()...)
+}
+
+// MakeExecutedBatchStatsKey returns the following key format
+// prefix              token contract                       batch nonce
+// [0x24][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeExecutedBatchStatsKey(tokenContract common.Address, nonce uint64) []byte {
+	return append(MakeExecutedBatchStatsPrefix(tokenContract), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// MakeExecutedBatchStatsPrefix returns the prefix of the executed batch stats of a token
+func MakeExecutedBatchStatsPrefix(tokenContract common.Address) []byte {
+	return append([]byte{ExecutedBatchStatsKey}, tokenContract.Bytes()...)
+}
+
+// MakeOutgoingBatchTxByTokenKey returns the following key format
+// prefix              token contract                       batch nonce
+// [0x25][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeOutgoingBatchTxByTokenKey(tokenContract common.Address, nonce uint64) []byte {
+	return append(MakeOutgoingBatchTxByTokenPrefix(tokenContract), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// MakeOutgoingBatchTxByTokenPrefix returns the prefix of the outgoing batch txs of a token
+func MakeOutgoingBatchTxByTokenPrefix(tokenContract common.Address) []byte {
+	return append([]byte{OutgoingBatchTxByTokenKey}, tokenContract.Bytes()...)
+}
+
+// MakeOutgoingContractCallTxByScopeKey returns the following key format, hashing the variable
+// length invalidation scope so that no scope is a prefix of another
+// prefix          sha256(invalidation scope)             invalidation nonce
+// [0x26][0x2c26b46b68ffc68ff99b453c1d304134...][0 0 0 0 0 0 0 1]
+func MakeOutgoingContractCallTxByScopeKey(invalidationScope []byte, nonce uint64) []byte {
+	return append(MakeOutgoingContractCallTxByScopePrefix(invalidationScope), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// MakeOutgoingContractCallTxByScopePrefix returns the prefix of the outgoing contract call txs of an invalidation scope
+func MakeOutgoingContractCallTxByScopePrefix(invalidationScope []byte) []byte {
+	scopeHash := sha256.Sum256(invalidationScope)
+	return append([]byte{OutgoingContractCallTxByScopeKey}, scopeHash[:]...)
+}
+
+// MakeOutgoingTxTimeoutKey returns the following key format
+// prefix  type      timeout height         store index
+// [0x27][0x2][0 0 0 0 0 0 1 244][0x2 0xc783df8a850f42e7F7e57013759C285caa701eB6 0 0 0 0 0 0 0 1]
+func MakeOutgoingTxTimeoutKey(prefixByte byte, timeout uint64, storeIndex []byte) []byte {
+	return bytes.Join([][]byte{MakeOutgoingTxTimeoutPrefix(prefixByte), sdk.Uint64ToBigEndian(timeout), storeIndex}, []byte{})
+}
+
+// MakeOutgoingTxTimeoutPrefix returns the prefix of the timeout queue of a type of outgoing tx
+func MakeOutgoingTxTimeoutPrefix(prefixByte byte) []byte {
+	return []byte{OutgoingTxTimeoutKey, prefixByte}
+}
+
+// MakeOutgoingTxByHeightKey returns the following key format
+// prefix          height                     store index
+// [0x28][0 0 0 0 0 0 0 100][0x2 0xc783df8a850f42e7F7e57013759C285caa701eB6 0 0 0 0 0 0 0 1]
+func MakeOutgoingTxByHeightKey(height uint64, storeIndex []byte) []byte {
+	return bytes.Join([][]byte{{OutgoingTxByHeightKey}, sdk.Uint64ToBigEndian(height), storeIndex}, []byte{})
+}
+
+// MakeSignerSetHashKey returns the following key format
+// prefix          nonce
+// [0x2a][0 0 0 0 0 0 0 1]
+func MakeSignerSetHashKey(nonce uint64) []byte {
+	return append([]byte{SignerSetHashKey}, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// MakeSignerSetHijackIncidentKey returns the following key format
+// prefix          nonce
+// [0x2b][0 0 0 0 0 0 0 1]
+func MakeSignerSetHijackIncidentKey(nonce uint64) []byte {
+	return append([]byte{SignerSetHijackIncidentKey}, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// MakeEthereumSignatureCheckpointKey returns the following key format
+// prefix                       checkpoint
+// [0x2c][0xc783df8a850f42e7F7e57013759C285caa701eB6c783df8a850f42e7]
+func MakeEthereumSignatureCheckpointKey(checkpoint []byte) []byte {
+	return append([]byte{EthereumSignatureCheckpointKey}, checkpoint...)
+}
+
+// MakeBadSignatureEvidenceKey returns the following key format
+// prefix                       checkpoint                                          ethereum signer
+// [0x2d][0xc783df8a850f42e7F7e57013759C285caa701eB6c783df8a850f42e7][0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7]
+func MakeBadSignatureEvidenceKey(checkpoint []byte, ethereumSigner common.Address) []byte {
+	return bytes.Join([][]byte{{BadSignatureEvidenceKey}, checkpoint, ethereumSigner.Bytes()}, []byte{})
+}
+
+// MakeConflictingEventVoteKey returns the following key format
+// prefix   cosmos-validator                                      event nonce
+// [0x2f][len][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func MakeConflictingEventVoteKey(validator sdk.ValAddress, eventNonce uint64) []byte {
+	return append(MakeConflictingEventVotePrefix(validator), sdk.Uint64ToBigEndian(eventNonce)...)
+}
+
+// MakeConflictingEventVotePrefix returns the prefix of the conflicting event votes of a validator
+func MakeConflictingEventVotePrefix(validator sdk.ValAddress) []byte {
+	return append([]byte{ConflictingEventVoteKey}, address.MustLengthPrefix(validator)...)
+}
+
+// MakeEthereumEventAcceptedHeightKey returns the following key format
+// prefix   event nonce
+// [0x30][0 0 0 0 0 0 0 1]
+func MakeEthereumEventAcceptedHeightKey(eventNonce uint64) []byte {
+	return append([]byte{EthereumEventAcceptedHeightKey}, sdk.Uint64ToBigEndian(eventNonce)...)
+}
+
+// MakeEthereumEventExcusedNonceKey returns the following key format
+// prefix   cosmos-validator
+// [0x31][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeEthereumEventExcusedNonceKey(validator sdk.ValAddress) []byte {
+	return append([]byte{EthereumEventExcusedNonceKey}, validator.Bytes()...)
+}
+
+// MakeBridgeSigningInfoKey returns the following key format
+// prefix   cosmos-validator
+// [0x33][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeBridgeSigningInfoKey(validator sdk.ValAddress) []byte {
+	return append([]byte{BridgeSigningInfoKey}, validator.Bytes()...)
+}
+
+// MakeLaggingOracleValidatorKey returns the following key format
+// prefix   cosmos-validator
+// [0x34][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeLaggingOracleValidatorKey(validator sdk.ValAddress) []byte {
+	return append([]byte{LaggingOracleValidatorKey}, validator.Bytes()...)
+}
+
+// MakeReturnedQuarantinedDepositKey returns the following key format
+// prefix          id
+// [0x35][0 0 0 0 0 0 0 1]
+func MakeReturnedQuarantinedDepositKey(sendToEthereumID uint64) []byte {
+	return append([]byte{ReturnedQuarantinedDepositKey}, sdk.Uint64ToBigEndian(sendToEthereumID)...)
+}
+
+// MakeSendToEthereumStatusKey returns the following key format
+// prefix          id
+// [0x21][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumStatusKey(id uint64) []byte {
+	return append([]byte{SendToEthereumStatusKey}, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeSendToEthereumStatusPruneKey returns the following key format
+// prefix          height              id
+// [0x22][0 0 0 0 0 0 0 100][0 0 0 0 0 0 0 1]
+func MakeSendToEthereumStatusPruneKey(height, id uint64) []byte {
+	return bytes.Join([][]byte{{SendToEthereumStatusPruneKey}, sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(id)}, []byte{})
+}
+
+// MakeOutflowKey returns the following key format
+// prefix              token contract                          height
+// [0x18][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 100]
+func MakeOutflowKey(tokenContract common.Address, height uint64) []byte {
+	return bytes.Join([][]byte{{OutflowKey}, tokenContract.Bytes(), sdk.Uint64ToBigEndian(height)}, []byte{})
+}
+
+// MakeOutflowPausedKey returns the following key format
+// prefix              token contract
+// [0x19][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeOutflowPausedKey(tokenContract common.Address) []byte {
+	return append([]byte{OutflowPausedKey}, tokenContract.Bytes()...)
+}
+
+// MakeInflowKey returns the following key format
+// prefix              token contract                          height
+// [0x1a][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 100]
+func MakeInflowKey(tokenContract common.Address, height uint64) []byte {
+	return bytes.Join([][]byte{{InflowKey}, tokenContract.Bytes(), sdk.Uint64ToBigEndian(height)}, []byte{})
+}
+
+// MakeQuarantinedDepositKey returns the following key format
+// prefix          id
+// [0x1b][0 0 0 0 0 0 0 1]
+func MakeQuarantinedDepositKey(id uint64) []byte {
+	return append([]byte{QuarantinedDepositKey}, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MakeEthereumDenylistKey returns the following key format
+// prefix  ethereum-address
+// [0x1d][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeEthereumDenylistKey(address common.Address) []byte {
+	return append([]byte{EthereumDenylistKey}, address.Bytes()...)
+}
+
 // MakeLastEventNonceByValidatorKey indexes lateset event nonce by validator
 // MakeLastEventNonceByValidatorKey returns the following key format
 // prefix              cosmos-validator
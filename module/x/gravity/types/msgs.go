@@ -13,14 +13,17 @@ var (
 	_ sdk.Msg = &MsgDelegateKeys{}
 	_ sdk.Msg = &MsgSendToEthereum{}
 	_ sdk.Msg = &MsgCancelSendToEthereum{}
+	_ sdk.Msg = &MsgIncreaseBridgeFee{}
 	_ sdk.Msg = &MsgRequestBatchTx{}
 	_ sdk.Msg = &MsgSubmitEthereumEvent{}
 	_ sdk.Msg = &MsgSubmitEthereumTxConfirmation{}
 	_ sdk.Msg = &MsgEthereumHeightVote{}
+	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumEvent{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumTxConfirmation{}
 	_ cdctypes.UnpackInterfacesMessage = &EthereumEventVoteRecord{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitBadSignatureEvidence{}
 )
 
 // NewMsgDelegateKeys returns a reference to a new MsgDelegateKeys.
@@ -148,6 +151,47 @@ func (msg *MsgSubmitEthereumTxConfirmation) UnpackInterfaces(unpacker cdctypes.A
 	return unpacker.UnpackAny(msg.Confirmation, &sig)
 }
 
+// Route should return the name of the module
+func (msg *MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitBadSignatureEvidence) Type() string { return "submit_bad_signature_evidence" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSubmitBadSignatureEvidence) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if !common.IsHexAddress(msg.EthereumSigner) {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum signer")
+	}
+	if len(msg.Signature) == 0 {
+		return ErrEmptyEthSig
+	}
+
+	_, err = UnpackOutgoingTx(msg.Subject)
+	return err
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitBadSignatureEvidence) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitBadSignatureEvidence) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+func (msg *MsgSubmitBadSignatureEvidence) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var subject OutgoingTx
+	return unpacker.UnpackAny(msg.Subject, &subject)
+}
+
 // NewMsgSendToEthereum returns a new MsgSendToEthereum
 func NewMsgSendToEthereum(sender sdk.AccAddress, destAddress string, send sdk.Coin, bridgeFee sdk.Coin) *MsgSendToEthereum {
 	return &MsgSendToEthereum{
@@ -286,6 +330,50 @@ func (msg MsgCancelSendToEthereum) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
+// NewMsgIncreaseBridgeFee returns a new MsgIncreaseBridgeFee
+func NewMsgIncreaseBridgeFee(id uint64, sender sdk.AccAddress, feeIncrease sdk.Coin) *MsgIncreaseBridgeFee {
+	return &MsgIncreaseBridgeFee{
+		Id:          id,
+		Sender:      sender.String(),
+		FeeIncrease: feeIncrease,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgIncreaseBridgeFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgIncreaseBridgeFee) Type() string { return "increase_bridge_fee" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgIncreaseBridgeFee) ValidateBasic() error {
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "Id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if !msg.FeeIncrease.IsValid() || msg.FeeIncrease.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee increase")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgIncreaseBridgeFee) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgIncreaseBridgeFee) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
 // NewMsgEthereumHeightVote returns a new MsgEthereumHeightVote
 func NewMsgEthereumHeightVote(ethereumHeight uint64, signer sdk.AccAddress) *MsgEthereumHeightVote {
 	return &MsgEthereumHeightVote{
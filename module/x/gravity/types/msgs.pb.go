@@ -243,6 +243,106 @@ func (m *MsgCancelSendToEthereumResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelSendToEthereumResponse proto.InternalMessageInfo
 
+// MsgIncreaseBridgeFee allows the sender to add to the bridge fee of its own
+// unbatched SendToEthereum tx. The tx keeps its ID and is re-ordered in the
+// pool according to the new fee. The fee increase must be of the same denom as
+// the original transfer.
+type MsgIncreaseBridgeFee struct {
+	Id          uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender      string     `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	FeeIncrease types.Coin `protobuf:"bytes,3,opt,name=fee_increase,json=feeIncrease,proto3" json:"fee_increase"`
+}
+
+func (m *MsgIncreaseBridgeFee) Reset()         { *m = MsgIncreaseBridgeFee{} }
+func (m *MsgIncreaseBridgeFee) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseBridgeFee) ProtoMessage()    {}
+func (*MsgIncreaseBridgeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{4}
+}
+func (m *MsgIncreaseBridgeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseBridgeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseBridgeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseBridgeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseBridgeFee.Merge(m, src)
+}
+func (m *MsgIncreaseBridgeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseBridgeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseBridgeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreaseBridgeFee proto.InternalMessageInfo
+
+func (m *MsgIncreaseBridgeFee) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgIncreaseBridgeFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgIncreaseBridgeFee) GetFeeIncrease() types.Coin {
+	if m != nil {
+		return m.FeeIncrease
+	}
+	return types.Coin{}
+}
+
+type MsgIncreaseBridgeFeeResponse struct {
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) Reset()         { *m = MsgIncreaseBridgeFeeResponse{} }
+func (m *MsgIncreaseBridgeFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseBridgeFeeResponse) ProtoMessage()    {}
+func (*MsgIncreaseBridgeFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{5}
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseBridgeFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseBridgeFeeResponse.Merge(m, src)
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseBridgeFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreaseBridgeFeeResponse proto.InternalMessageInfo
+
 // MsgRequestBatchTx requests a batch of transactions with a given coin
 // denomination to send across the bridge to Ethereum.
 type MsgRequestBatchTx struct {
@@ -254,7 +354,7 @@ func (m *MsgRequestBatchTx) Reset()         { *m = MsgRequestBatchTx{} }
 func (m *MsgRequestBatchTx) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchTx) ProtoMessage()    {}
 func (*MsgRequestBatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{4}
+	return fileDescriptor_2f8523f2f6feb451, []int{6}
 }
 func (m *MsgRequestBatchTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +404,7 @@ func (m *MsgRequestBatchTxResponse) Reset()         { *m = MsgRequestBatchTxResp
 func (m *MsgRequestBatchTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchTxResponse) ProtoMessage()    {}
 func (*MsgRequestBatchTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{5}
+	return fileDescriptor_2f8523f2f6feb451, []int{7}
 }
 func (m *MsgRequestBatchTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +445,7 @@ func (m *MsgSubmitEthereumTxConfirmation) Reset()         { *m = MsgSubmitEthere
 func (m *MsgSubmitEthereumTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumTxConfirmation) ProtoMessage()    {}
 func (*MsgSubmitEthereumTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{6}
+	return fileDescriptor_2f8523f2f6feb451, []int{8}
 }
 func (m *MsgSubmitEthereumTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +487,7 @@ func (m *ContractCallTxConfirmation) Reset()         { *m = ContractCallTxConfir
 func (m *ContractCallTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxConfirmation) ProtoMessage()    {}
 func (*ContractCallTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{7}
+	return fileDescriptor_2f8523f2f6feb451, []int{9}
 }
 func (m *ContractCallTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +556,7 @@ func (m *BatchTxConfirmation) Reset()         { *m = BatchTxConfirmation{} }
 func (m *BatchTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*BatchTxConfirmation) ProtoMessage()    {}
 func (*BatchTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{8}
+	return fileDescriptor_2f8523f2f6feb451, []int{10}
 }
 func (m *BatchTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +625,7 @@ func (m *SignerSetTxConfirmation) Reset()         { *m = SignerSetTxConfirmation
 func (m *SignerSetTxConfirmation) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxConfirmation) ProtoMessage()    {}
 func (*SignerSetTxConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{9}
+	return fileDescriptor_2f8523f2f6feb451, []int{11}
 }
 func (m *SignerSetTxConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +684,7 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) Reset() {
 func (m *MsgSubmitEthereumTxConfirmationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumTxConfirmationResponse) ProtoMessage()    {}
 func (*MsgSubmitEthereumTxConfirmationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{10}
+	return fileDescriptor_2f8523f2f6feb451, []int{12}
 }
 func (m *MsgSubmitEthereumTxConfirmationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +723,7 @@ func (m *MsgSubmitEthereumEvent) Reset()         { *m = MsgSubmitEthereumEvent{}
 func (m *MsgSubmitEthereumEvent) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumEvent) ProtoMessage()    {}
 func (*MsgSubmitEthereumEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{11}
+	return fileDescriptor_2f8523f2f6feb451, []int{13}
 }
 func (m *MsgSubmitEthereumEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +759,7 @@ func (m *MsgSubmitEthereumEventResponse) Reset()         { *m = MsgSubmitEthereu
 func (m *MsgSubmitEthereumEventResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitEthereumEventResponse) ProtoMessage()    {}
 func (*MsgSubmitEthereumEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{12}
+	return fileDescriptor_2f8523f2f6feb451, []int{14}
 }
 func (m *MsgSubmitEthereumEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,7 +802,7 @@ func (m *MsgDelegateKeys) Reset()         { *m = MsgDelegateKeys{} }
 func (m *MsgDelegateKeys) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateKeys) ProtoMessage()    {}
 func (*MsgDelegateKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{13}
+	return fileDescriptor_2f8523f2f6feb451, []int{15}
 }
 func (m *MsgDelegateKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -766,7 +866,7 @@ func (m *MsgDelegateKeysResponse) Reset()         { *m = MsgDelegateKeysResponse
 func (m *MsgDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateKeysResponse) ProtoMessage()    {}
 func (*MsgDelegateKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{14}
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
 }
 func (m *MsgDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +907,7 @@ func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
 func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysSignMsg) ProtoMessage()    {}
 func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{15}
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
 }
 func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,7 +961,7 @@ func (m *MsgEthereumHeightVote) Reset()         { *m = MsgEthereumHeightVote{} }
 func (m *MsgEthereumHeightVote) String() string { return proto.CompactTextString(m) }
 func (*MsgEthereumHeightVote) ProtoMessage()    {}
 func (*MsgEthereumHeightVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
 }
 func (m *MsgEthereumHeightVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,7 +1011,7 @@ func (m *MsgEthereumHeightVoteResponse) Reset()         { *m = MsgEthereumHeight
 func (m *MsgEthereumHeightVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEthereumHeightVoteResponse) ProtoMessage()    {}
 func (*MsgEthereumHeightVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
 }
 func (m *MsgEthereumHeightVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,6 +1040,85 @@ func (m *MsgEthereumHeightVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEthereumHeightVoteResponse proto.InternalMessageInfo
 
+// MsgSubmitBadSignatureEvidence submits the signature of a validator's
+// ethereum key over the checkpoint of an outgoing tx the module never
+// created. Anyone can submit it, and the validator is slashed and jailed.
+type MsgSubmitBadSignatureEvidence struct {
+	Subject        *types1.Any `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Signature      []byte      `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	EthereumSigner string      `protobuf:"bytes,3,opt,name=ethereum_signer,json=ethereumSigner,proto3" json:"ethereum_signer,omitempty"`
+	Sender         string      `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSignatureEvidence{} }
+func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
+func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBadSignatureEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidence.Merge(m, src)
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBadSignatureEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBadSignatureEvidence proto.InternalMessageInfo
+
+type MsgSubmitBadSignatureEvidenceResponse struct {
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmitBadSignatureEvidenceResponse{} }
+func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse proto.InternalMessageInfo
+
 // SendToCosmosEvent is submitted when the SendToCosmosEvent is emitted by they
 // gravity contract. ERC20 representation coins are minted to the cosmosreceiver
 // address.
@@ -956,7 +1135,7 @@ func (m *SendToCosmosEvent) Reset()         { *m = SendToCosmosEvent{} }
 func (m *SendToCosmosEvent) String() string { return proto.CompactTextString(m) }
 func (*SendToCosmosEvent) ProtoMessage()    {}
 func (*SendToCosmosEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
 }
 func (m *SendToCosmosEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1033,7 +1212,7 @@ func (m *BatchExecutedEvent) Reset()         { *m = BatchExecutedEvent{} }
 func (m *BatchExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*BatchExecutedEvent) ProtoMessage()    {}
 func (*BatchExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
 }
 func (m *BatchExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1282,7 @@ func (m *ContractCallExecutedEvent) Reset()         { *m = ContractCallExecutedE
 func (m *ContractCallExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*ContractCallExecutedEvent) ProtoMessage()    {}
 func (*ContractCallExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
 }
 func (m *ContractCallExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1355,7 @@ func (m *ERC20DeployedEvent) Reset()         { *m = ERC20DeployedEvent{} }
 func (m *ERC20DeployedEvent) String() string { return proto.CompactTextString(m) }
 func (*ERC20DeployedEvent) ProtoMessage()    {}
 func (*ERC20DeployedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{21}
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
 }
 func (m *ERC20DeployedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1267,7 +1446,7 @@ func (m *SignerSetTxExecutedEvent) Reset()         { *m = SignerSetTxExecutedEve
 func (m *SignerSetTxExecutedEvent) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxExecutedEvent) ProtoMessage()    {}
 func (*SignerSetTxExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{22}
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
 }
 func (m *SignerSetTxExecutedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,6 +1508,8 @@ func init() {
 	proto.RegisterType((*MsgSendToEthereumResponse)(nil), "gravity.v1.MsgSendToEthereumResponse")
 	proto.RegisterType((*MsgCancelSendToEthereum)(nil), "gravity.v1.MsgCancelSendToEthereum")
 	proto.RegisterType((*MsgCancelSendToEthereumResponse)(nil), "gravity.v1.MsgCancelSendToEthereumResponse")
+	proto.RegisterType((*MsgIncreaseBridgeFee)(nil), "gravity.v1.MsgIncreaseBridgeFee")
+	proto.RegisterType((*MsgIncreaseBridgeFeeResponse)(nil), "gravity.v1.MsgIncreaseBridgeFeeResponse")
 	proto.RegisterType((*MsgRequestBatchTx)(nil), "gravity.v1.MsgRequestBatchTx")
 	proto.RegisterType((*MsgRequestBatchTxResponse)(nil), "gravity.v1.MsgRequestBatchTxResponse")
 	proto.RegisterType((*MsgSubmitEthereumTxConfirmation)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmation")
@@ -1343,6 +1524,8 @@ func init() {
 	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
 	proto.RegisterType((*MsgEthereumHeightVote)(nil), "gravity.v1.MsgEthereumHeightVote")
 	proto.RegisterType((*MsgEthereumHeightVoteResponse)(nil), "gravity.v1.MsgEthereumHeightVoteResponse")
+	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
+	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
 	proto.RegisterType((*SendToCosmosEvent)(nil), "gravity.v1.SendToCosmosEvent")
 	proto.RegisterType((*BatchExecutedEvent)(nil), "gravity.v1.BatchExecutedEvent")
 	proto.RegisterType((*ContractCallExecutedEvent)(nil), "gravity.v1.ContractCallExecutedEvent")
@@ -1353,91 +1536,100 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 1341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x13, 0x17, 0x25, 0xd9, 0x81, 0x47, 0x8e, 0x3f, 0x68, 0x27, 0x91, 0x15, 0x47, 0xb2, 0x15, 0xe4,
-	0x1f, 0xe7, 0x1f, 0x88, 0x8c, 0x95, 0x00, 0x2d, 0x52, 0xa0, 0x80, 0x25, 0x3b, 0x48, 0x51, 0x38,
-	0x07, 0x29, 0x29, 0x8c, 0x5e, 0x04, 0x8a, 0x9c, 0x50, 0x4c, 0x44, 0xae, 0xca, 0x5d, 0x09, 0xd6,
-	0xad, 0xe8, 0xa9, 0xe8, 0xa9, 0x7d, 0x83, 0x1c, 0x82, 0x3e, 0x41, 0x5e, 0x20, 0xb7, 0x34, 0xa7,
-	0x00, 0x05, 0x8a, 0xa2, 0x87, 0xa0, 0x48, 0x2e, 0x7d, 0x86, 0x02, 0x05, 0x0a, 0xee, 0x2e, 0x65,
-	0x92, 0xa2, 0xbf, 0x80, 0x9e, 0xc4, 0x9d, 0x99, 0x9d, 0xf9, 0xcd, 0xec, 0x6f, 0x77, 0x46, 0x70,
-	0xc9, 0xf6, 0x8d, 0x91, 0xc3, 0xc6, 0xfa, 0x68, 0x5b, 0x77, 0xa9, 0x4d, 0xb5, 0x81, 0x4f, 0x18,
-	0x51, 0x41, 0x8a, 0xb5, 0xd1, 0x76, 0xa9, 0x6c, 0x12, 0xea, 0x12, 0xaa, 0x77, 0x0d, 0x8a, 0xfa,
-	0x68, 0xbb, 0x8b, 0xcc, 0xd8, 0xd6, 0x4d, 0xe2, 0x78, 0xc2, 0xb6, 0xb4, 0x26, 0xf4, 0x1d, 0xbe,
-	0xd2, 0xc5, 0x42, 0xaa, 0x56, 0x6d, 0x62, 0x13, 0x21, 0x0f, 0xbe, 0xa4, 0x74, 0xdd, 0x26, 0xc4,
-	0xee, 0xa3, 0x6e, 0x0c, 0x1c, 0xdd, 0xf0, 0x3c, 0xc2, 0x0c, 0xe6, 0x10, 0x2f, 0xdc, 0xb3, 0x26,
-	0xb5, 0x7c, 0xd5, 0x1d, 0x3e, 0xd5, 0x0d, 0x6f, 0x2c, 0x55, 0xc5, 0x08, 0xd8, 0x10, 0x20, 0xd7,
-	0x54, 0x7f, 0x55, 0x60, 0x79, 0x9f, 0xda, 0x6d, 0xf4, 0xac, 0xc7, 0x64, 0x8f, 0xf5, 0xd0, 0xc7,
-	0xa1, 0xab, 0x5e, 0x86, 0x59, 0x8a, 0x9e, 0x85, 0x7e, 0x51, 0xd9, 0x50, 0xb6, 0xe6, 0x5a, 0x72,
-	0xa5, 0xd6, 0x40, 0x45, 0x69, 0xd3, 0xf1, 0xd1, 0x74, 0x06, 0x0e, 0x7a, 0xac, 0x98, 0xe5, 0x36,
-	0xcb, 0xa1, 0xa6, 0x15, 0x2a, 0xd4, 0x4f, 0x60, 0xd6, 0x70, 0xc9, 0xd0, 0x63, 0xc5, 0xdc, 0x86,
-	0xb2, 0x55, 0xa8, 0xaf, 0x69, 0x32, 0xc9, 0xa0, 0x22, 0x9a, 0xac, 0x88, 0xd6, 0x24, 0x8e, 0xd7,
-	0xc8, 0xbf, 0x79, 0x5f, 0xc9, 0xb4, 0xa4, 0xb9, 0xfa, 0x39, 0x40, 0xd7, 0x77, 0x2c, 0x1b, 0x3b,
-	0x4f, 0x11, 0x8b, 0xf9, 0xb3, 0x6d, 0x9e, 0x13, 0x5b, 0x1e, 0x20, 0x56, 0x6f, 0xc3, 0xda, 0x54,
-	0x52, 0x2d, 0xa4, 0x03, 0xe2, 0x51, 0x54, 0x17, 0x20, 0xeb, 0x58, 0x3c, 0xb1, 0x7c, 0x2b, 0xeb,
-	0x58, 0xd5, 0x1d, 0xb8, 0xb2, 0x4f, 0xed, 0xa6, 0xe1, 0x99, 0xd8, 0x4f, 0xd4, 0x21, 0x61, 0x1a,
-	0xa9, 0x4b, 0x36, 0x5a, 0x97, 0xea, 0x26, 0x54, 0x8e, 0x71, 0x11, 0x46, 0xad, 0xee, 0xf0, 0x3a,
-	0xb7, 0xf0, 0x9b, 0x21, 0x52, 0xd6, 0x30, 0x98, 0xd9, 0x7b, 0x7c, 0xa8, 0xae, 0xc2, 0x8c, 0x85,
-	0x1e, 0x71, 0x65, 0x99, 0xc5, 0x82, 0x47, 0x71, 0x6c, 0x2f, 0x12, 0x85, 0xaf, 0xaa, 0x57, 0x79,
-	0x56, 0x71, 0x17, 0x13, 0xff, 0x2f, 0x14, 0x8e, 0xa1, 0x3d, 0xec, 0xba, 0x0e, 0x0b, 0xa3, 0x3f,
-	0x3e, 0x6c, 0x12, 0xef, 0xa9, 0xe3, 0xbb, 0x9c, 0x28, 0x6a, 0x07, 0xe6, 0xcd, 0xc8, 0x9a, 0x47,
-	0x2d, 0xd4, 0x57, 0x35, 0x41, 0x1c, 0x2d, 0x24, 0x8e, 0xb6, 0xe3, 0x8d, 0x1b, 0x37, 0xde, 0xbe,
-	0xaa, 0x6d, 0x1e, 0x91, 0x59, 0x4b, 0x77, 0xd9, 0x8a, 0x39, 0x3c, 0x0e, 0xf9, 0xfd, 0xfc, 0xf7,
-	0x2f, 0x2a, 0x99, 0xea, 0x6b, 0x05, 0x4a, 0x4d, 0xe2, 0x31, 0xdf, 0x30, 0x59, 0xd3, 0xe8, 0xf7,
-	0x13, 0xe8, 0x6a, 0xa0, 0x3a, 0xde, 0xc8, 0xe8, 0x3b, 0x16, 0x5f, 0x77, 0xa8, 0x49, 0x06, 0xc8,
-	0x31, 0xce, 0xb7, 0x96, 0xa3, 0x9a, 0x76, 0xa0, 0x98, 0x32, 0xf7, 0x88, 0x67, 0x22, 0x8f, 0x9b,
-	0x8f, 0x9b, 0x3f, 0x0a, 0x14, 0xea, 0x4d, 0x58, 0x9c, 0x50, 0x57, 0x62, 0xcc, 0x71, 0x8c, 0x0b,
-	0xa1, 0xb8, 0xcd, 0xa5, 0xea, 0x3a, 0xcc, 0x05, 0x7a, 0x83, 0x0d, 0x7d, 0x41, 0xbd, 0xf9, 0xd6,
-	0x91, 0xa0, 0xfa, 0x52, 0x81, 0x15, 0x59, 0xfa, 0x18, 0xf8, 0x1b, 0xb0, 0xc0, 0xc8, 0x73, 0xf4,
-	0x3a, 0xa6, 0x4c, 0x50, 0x1e, 0xe9, 0x45, 0x2e, 0x0d, 0xb3, 0x56, 0x2b, 0x50, 0xe8, 0x06, 0xbb,
-	0x63, 0x68, 0x81, 0x8b, 0xfe, 0x53, 0x98, 0x3f, 0x28, 0x70, 0x45, 0x18, 0xb6, 0x91, 0x25, 0xa0,
-	0x6e, 0xc1, 0x92, 0xf0, 0xdc, 0xa1, 0xc8, 0x24, 0x10, 0x41, 0xf1, 0x05, 0x1a, 0x6e, 0x39, 0x16,
-	0x4c, 0xf6, 0x74, 0x30, 0xb9, 0x24, 0x98, 0x5b, 0x70, 0xf3, 0x14, 0x66, 0x4e, 0x58, 0xfc, 0xad,
-	0x02, 0x97, 0xa7, 0x6c, 0xf7, 0x46, 0xc1, 0x63, 0xf2, 0x10, 0x66, 0x30, 0xf8, 0x38, 0x91, 0xb5,
-	0xeb, 0x6f, 0x5f, 0xd5, 0x8a, 0x29, 0xac, 0xe5, 0x2e, 0x5a, 0xc2, 0xc1, 0x29, 0x2c, 0xdd, 0x80,
-	0x72, 0x3a, 0x82, 0x09, 0xc8, 0xd7, 0x0a, 0x2c, 0xee, 0x53, 0x7b, 0x17, 0xfb, 0x68, 0x1b, 0x0c,
-	0xbf, 0xc4, 0x31, 0x55, 0x6f, 0xc3, 0xb2, 0x64, 0x1c, 0xf1, 0x3b, 0x86, 0x65, 0xf9, 0x48, 0xa9,
-	0xa4, 0xc0, 0xd2, 0x44, 0xb1, 0x23, 0xe4, 0xea, 0x36, 0xac, 0x12, 0xdf, 0xec, 0x21, 0x65, 0x7e,
-	0xcc, 0x5e, 0xc0, 0x59, 0x89, 0xea, 0xc2, 0x2d, 0xb7, 0x60, 0x69, 0x72, 0x14, 0xa1, 0xb9, 0x20,
-	0xc6, 0xe4, 0x88, 0x42, 0xd3, 0xeb, 0x70, 0x11, 0x59, 0xaf, 0x93, 0x64, 0xc7, 0x3c, 0xb2, 0x5e,
-	0x7b, 0x72, 0x26, 0x6b, 0xfc, 0xd1, 0x8b, 0xa6, 0x30, 0x49, 0xef, 0x00, 0x56, 0xa2, 0xf2, 0x60,
-	0xcf, 0x3e, 0xb5, 0xcf, 0x97, 0xe1, 0x2a, 0xcc, 0x44, 0x19, 0x2e, 0x16, 0xd5, 0x03, 0xb8, 0xb4,
-	0x4f, 0xed, 0xb0, 0xa8, 0x0f, 0xd1, 0xb1, 0x7b, 0xec, 0x2b, 0xc2, 0xe2, 0x44, 0xeb, 0x71, 0x71,
-	0xc8, 0x48, 0x8c, 0x19, 0x1f, 0xfb, 0x34, 0x56, 0xe0, 0x5a, 0xaa, 0xe7, 0x49, 0x52, 0x2f, 0xb3,
-	0xb0, 0x2c, 0x5e, 0xe6, 0x26, 0xef, 0x22, 0x82, 0x53, 0x15, 0x28, 0x70, 0x4a, 0xc4, 0x6e, 0x01,
-	0x70, 0x91, 0xb8, 0x01, 0xd3, 0xd7, 0x3a, 0x9b, 0x76, 0xad, 0x1f, 0xc4, 0x1a, 0xdd, 0x5c, 0x43,
-	0x0b, 0x1a, 0xd2, 0x1f, 0xef, 0x2b, 0xff, 0xb3, 0x1d, 0xd6, 0x1b, 0x76, 0x35, 0x93, 0xb8, 0xb2,
-	0xbf, 0xcb, 0x9f, 0x1a, 0xb5, 0x9e, 0xeb, 0x6c, 0x3c, 0x40, 0xaa, 0x7d, 0xe1, 0xb1, 0x49, 0xdf,
-	0x8b, 0x5d, 0x38, 0xd1, 0x68, 0xf2, 0x89, 0x0b, 0x27, 0x1a, 0xf1, 0x4d, 0x58, 0x94, 0xc3, 0x83,
-	0x8f, 0x26, 0x3a, 0x23, 0xf4, 0x8b, 0x33, 0xc2, 0x50, 0x88, 0x5b, 0x52, 0x9a, 0x56, 0xd9, 0xd9,
-	0xb4, 0xca, 0xde, 0xcf, 0xff, 0xf5, 0xa2, 0xa2, 0x54, 0x7f, 0x56, 0x40, 0xe5, 0xcf, 0xdb, 0xde,
-	0x21, 0x9a, 0x43, 0x86, 0x96, 0xa8, 0xd3, 0xd9, 0x5f, 0xb7, 0x68, 0x39, 0xb3, 0x53, 0xe5, 0x4c,
-	0x41, 0x93, 0x4b, 0x3d, 0xe7, 0xc4, 0x3b, 0x99, 0x4f, 0xbe, 0x93, 0xd5, 0x7f, 0x14, 0x58, 0x8b,
-	0xf6, 0x92, 0x38, 0xde, 0x53, 0xcf, 0xd5, 0x4e, 0xed, 0x35, 0x01, 0xe0, 0xf9, 0xc6, 0xa7, 0x7f,
-	0xbf, 0xaf, 0xdc, 0x8b, 0x1c, 0x1c, 0xe3, 0x25, 0x77, 0x1d, 0x8f, 0x45, 0x3f, 0xfb, 0x4e, 0x97,
-	0xea, 0xdd, 0x31, 0x43, 0xaa, 0x3d, 0xc4, 0xc3, 0x46, 0xf0, 0x71, 0xf6, 0x2e, 0x95, 0x3b, 0x4b,
-	0x97, 0x92, 0x05, 0xca, 0xa7, 0x15, 0xa8, 0xfa, 0x53, 0x16, 0xd4, 0xbd, 0x56, 0xb3, 0x7e, 0x67,
-	0x17, 0x07, 0x7d, 0x32, 0x3e, 0x73, 0xe2, 0x9b, 0xc1, 0x08, 0xc0, 0x89, 0x23, 0x06, 0x0f, 0x41,
-	0xe7, 0x82, 0x90, 0xed, 0xf2, 0xf1, 0x63, 0xfa, 0xb0, 0x73, 0x69, 0x87, 0x7d, 0x0d, 0x00, 0x7d,
-	0xb3, 0x7e, 0xa7, 0xe3, 0x19, 0x2e, 0x4a, 0x9a, 0xce, 0x71, 0xc9, 0x23, 0xc3, 0xe5, 0x81, 0x84,
-	0x9a, 0x8e, 0xdd, 0x2e, 0xe9, 0x4b, 0x7a, 0x16, 0xb8, 0xac, 0xcd, 0x45, 0x41, 0x20, 0x61, 0x62,
-	0xa1, 0xe9, 0xb8, 0x46, 0x9f, 0x4a, 0x6a, 0x5e, 0xe4, 0xd2, 0x5d, 0x29, 0x4c, 0xab, 0xc9, 0x85,
-	0xd4, 0x9a, 0xfc, 0xa2, 0x40, 0x31, 0xd2, 0xf4, 0xce, 0x49, 0x89, 0x1a, 0xac, 0x44, 0xda, 0x22,
-	0x3b, 0x8c, 0x91, 0x78, 0x89, 0x1e, 0xf9, 0x3d, 0x27, 0x95, 0xef, 0xc1, 0x05, 0x17, 0xdd, 0x2e,
-	0xfa, 0xb4, 0x98, 0xdf, 0xc8, 0x6d, 0x15, 0xea, 0x25, 0x2d, 0xa5, 0x41, 0x09, 0xdc, 0xad, 0xd0,
-	0xb4, 0xfe, 0xdb, 0x0c, 0xe4, 0x82, 0x57, 0xf7, 0x00, 0x16, 0x12, 0x33, 0xe9, 0xb5, 0xe8, 0xf6,
-	0xa9, 0x29, 0xb7, 0x74, 0xe3, 0x44, 0xf5, 0xe4, 0x3d, 0xcc, 0xa8, 0xcf, 0x60, 0x35, 0x75, 0xe6,
-	0xbd, 0x9e, 0x70, 0x90, 0x66, 0x54, 0xba, 0x7d, 0x06, 0xa3, 0x48, 0xac, 0x03, 0x58, 0x48, 0x4c,
-	0xbe, 0xc9, 0x2c, 0xe2, 0xea, 0xa9, 0x2c, 0x8e, 0x19, 0x7a, 0x33, 0xea, 0x77, 0x0a, 0xac, 0x9f,
-	0x38, 0xf3, 0x26, 0x91, 0x9e, 0x64, 0x5c, 0xba, 0x7b, 0x0e, 0xe3, 0x08, 0x08, 0x1b, 0x56, 0xd2,
-	0x26, 0x96, 0xea, 0x89, 0xde, 0xb8, 0x4d, 0xe9, 0xff, 0xa7, 0xdb, 0x44, 0x02, 0x3d, 0x81, 0xc5,
-	0x36, 0xb2, 0xd8, 0xe0, 0x71, 0x35, 0xe1, 0x20, 0xaa, 0x2c, 0x5d, 0x3f, 0x41, 0x19, 0xa3, 0x42,
-	0x31, 0x1e, 0x37, 0xd2, 0x9a, 0x37, 0x13, 0x2e, 0xa6, 0x4d, 0x4a, 0xb7, 0x4e, 0x35, 0x39, 0x8a,
-	0xd5, 0x78, 0xf2, 0xe6, 0x43, 0x59, 0x79, 0xf7, 0xa1, 0xac, 0xfc, 0xf9, 0xa1, 0xac, 0xfc, 0xf8,
-	0xb1, 0x9c, 0x79, 0xf7, 0xb1, 0x9c, 0xf9, 0xfd, 0x63, 0x39, 0xf3, 0xf5, 0x67, 0x91, 0x37, 0x77,
-	0x80, 0xb6, 0x3d, 0x7e, 0x36, 0x0a, 0xff, 0xac, 0xd6, 0xc4, 0x5f, 0x3b, 0xdd, 0x25, 0xd6, 0xb0,
-	0x8f, 0xfa, 0xa8, 0xae, 0x1f, 0x86, 0x2a, 0xd1, 0x45, 0xbb, 0xb3, 0x7c, 0x0c, 0xbc, 0xfb, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xc1, 0x2f, 0x4b, 0x97, 0x0f, 0x00, 0x00,
+	// 1478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x41, 0x6b, 0x1b, 0x47,
+	0x14, 0xf6, 0x4a, 0xb2, 0x8d, 0x9f, 0x1c, 0xc7, 0x5e, 0x3b, 0x89, 0xa4, 0xd8, 0x92, 0xad, 0xe0,
+	0xc6, 0x6e, 0x90, 0x14, 0x3b, 0x81, 0x96, 0x14, 0x0a, 0x96, 0xed, 0x90, 0x50, 0x9c, 0x82, 0xe4,
+	0x14, 0xd3, 0x8b, 0x58, 0xad, 0x9e, 0x57, 0x9b, 0x68, 0x77, 0xd4, 0x9d, 0x91, 0xb0, 0xa0, 0x87,
+	0x92, 0x53, 0xe9, 0xa9, 0xfd, 0x07, 0x39, 0x84, 0xfe, 0x82, 0x40, 0xcf, 0xb9, 0xa5, 0xb9, 0x34,
+	0xd0, 0x4b, 0xe9, 0x21, 0x94, 0xe4, 0xd2, 0xdf, 0x50, 0x28, 0x94, 0x9d, 0x99, 0x5d, 0xef, 0xae,
+	0xd6, 0xb2, 0x0c, 0x3d, 0x59, 0xf3, 0xde, 0x37, 0x6f, 0xbe, 0x79, 0xf3, 0xcd, 0xbc, 0xb7, 0x86,
+	0x2b, 0x86, 0xa3, 0xf5, 0x4d, 0x36, 0xa8, 0xf4, 0xb7, 0x2a, 0x16, 0x35, 0x68, 0xb9, 0xeb, 0x10,
+	0x46, 0x54, 0x90, 0xe6, 0x72, 0x7f, 0x2b, 0x97, 0xd7, 0x09, 0xb5, 0x08, 0xad, 0x34, 0x35, 0x8a,
+	0x95, 0xfe, 0x56, 0x13, 0x99, 0xb6, 0x55, 0xd1, 0x89, 0x69, 0x0b, 0x6c, 0x2e, 0x2b, 0xfc, 0x0d,
+	0x3e, 0xaa, 0x88, 0x81, 0x74, 0x2d, 0x19, 0xc4, 0x20, 0xc2, 0xee, 0xfe, 0x92, 0xd6, 0x65, 0x83,
+	0x10, 0xa3, 0x83, 0x15, 0xad, 0x6b, 0x56, 0x34, 0xdb, 0x26, 0x4c, 0x63, 0x26, 0xb1, 0xbd, 0x39,
+	0x59, 0xe9, 0xe5, 0xa3, 0x66, 0xef, 0xb8, 0xa2, 0xd9, 0x03, 0xe9, 0xca, 0x04, 0xc8, 0x7a, 0x04,
+	0xb9, 0xa7, 0xf8, 0xbb, 0x02, 0x0b, 0x07, 0xd4, 0xa8, 0xa3, 0xdd, 0x3a, 0x24, 0xfb, 0xac, 0x8d,
+	0x0e, 0xf6, 0x2c, 0xf5, 0x2a, 0x4c, 0x51, 0xb4, 0x5b, 0xe8, 0x64, 0x94, 0x55, 0x65, 0x63, 0xa6,
+	0x26, 0x47, 0x6a, 0x09, 0x54, 0x94, 0x98, 0x86, 0x83, 0xba, 0xd9, 0x35, 0xd1, 0x66, 0x99, 0x04,
+	0xc7, 0x2c, 0x78, 0x9e, 0x9a, 0xe7, 0x50, 0x3f, 0x81, 0x29, 0xcd, 0x22, 0x3d, 0x9b, 0x65, 0x92,
+	0xab, 0xca, 0x46, 0x7a, 0x3b, 0x5b, 0x96, 0x9b, 0x74, 0x33, 0x52, 0x96, 0x19, 0x29, 0xef, 0x12,
+	0xd3, 0xae, 0xa6, 0x5e, 0xbf, 0x2b, 0x4c, 0xd4, 0x24, 0x5c, 0xfd, 0x1c, 0xa0, 0xe9, 0x98, 0x2d,
+	0x03, 0x1b, 0xc7, 0x88, 0x99, 0xd4, 0x78, 0x93, 0x67, 0xc4, 0x94, 0xfb, 0x88, 0xc5, 0x5b, 0x90,
+	0x1d, 0xda, 0x54, 0x0d, 0x69, 0x97, 0xd8, 0x14, 0xd5, 0x39, 0x48, 0x98, 0x2d, 0xbe, 0xb1, 0x54,
+	0x2d, 0x61, 0xb6, 0x8a, 0x3b, 0x70, 0xed, 0x80, 0x1a, 0xbb, 0x9a, 0xad, 0x63, 0x27, 0x92, 0x87,
+	0x08, 0x34, 0x90, 0x97, 0x44, 0x30, 0x2f, 0xc5, 0x35, 0x28, 0x9c, 0x11, 0xc2, 0x5b, 0xb5, 0xf8,
+	0x4c, 0x81, 0xa5, 0x03, 0x6a, 0x3c, 0xb4, 0x75, 0x07, 0x35, 0x8a, 0x55, 0x8f, 0xeb, 0xb8, 0x6b,
+	0xa8, 0x55, 0x98, 0x3d, 0x46, 0x6c, 0x98, 0x32, 0xc0, 0xb8, 0x29, 0x4d, 0x1f, 0x23, 0x7a, 0x8b,
+	0x16, 0xf3, 0xb0, 0x1c, 0xc7, 0xc1, 0x27, 0xb9, 0xc3, 0xc5, 0x50, 0xc3, 0x6f, 0x7a, 0x48, 0x59,
+	0x55, 0x63, 0x7a, 0xfb, 0xf0, 0x44, 0x5d, 0x82, 0xc9, 0x16, 0xda, 0xc4, 0x92, 0x5a, 0x10, 0x03,
+	0x4e, 0xd3, 0x34, 0xec, 0x00, 0x4d, 0x3e, 0x2a, 0x5e, 0x87, 0xec, 0x50, 0x08, 0x3f, 0xfe, 0x73,
+	0x85, 0x27, 0xaa, 0xde, 0x6b, 0x5a, 0x26, 0xf3, 0x52, 0x74, 0x78, 0xb2, 0x4b, 0xec, 0x63, 0xd3,
+	0xb1, 0xb8, 0x9a, 0xd5, 0x06, 0xcc, 0xea, 0x81, 0x31, 0x5f, 0x35, 0xbd, 0xbd, 0x54, 0x16, 0xea,
+	0x2e, 0x7b, 0xea, 0x2e, 0xef, 0xd8, 0x83, 0xea, 0xfa, 0x9b, 0x97, 0xa5, 0xb5, 0xd3, 0x1b, 0x57,
+	0x8e, 0x0f, 0x59, 0x0b, 0x05, 0x3c, 0x8b, 0xf9, 0xbd, 0xd4, 0xf7, 0xcf, 0x0b, 0x13, 0xc5, 0x57,
+	0x0a, 0xe4, 0x76, 0x89, 0xcd, 0x1c, 0x4d, 0x67, 0xbb, 0x5a, 0xa7, 0x13, 0x61, 0x57, 0x02, 0xd5,
+	0xb4, 0xfb, 0x5a, 0xc7, 0x6c, 0xf1, 0x71, 0x83, 0xea, 0xa4, 0x8b, 0x9c, 0xe3, 0x6c, 0x6d, 0x21,
+	0xe8, 0xa9, 0xbb, 0x8e, 0x21, 0xb8, 0x4d, 0x6c, 0x1d, 0xf9, 0xba, 0xa9, 0x30, 0xfc, 0x91, 0xeb,
+	0x50, 0x6f, 0xc2, 0x65, 0xff, 0x7e, 0x49, 0x8e, 0x49, 0xce, 0x71, 0xce, 0x33, 0xd7, 0xb9, 0x55,
+	0x5d, 0x86, 0x19, 0xd7, 0xaf, 0xb1, 0x9e, 0x23, 0xee, 0xc7, 0x6c, 0xed, 0xd4, 0x50, 0x7c, 0xa1,
+	0xc0, 0xa2, 0x4c, 0x7d, 0x88, 0xfc, 0x3a, 0xcc, 0x31, 0xf2, 0x14, 0xed, 0x86, 0x2e, 0x37, 0x28,
+	0x8f, 0xf4, 0x12, 0xb7, 0x7a, 0xbb, 0x56, 0x0b, 0x90, 0x6e, 0xba, 0xb3, 0x43, 0x6c, 0x81, 0x9b,
+	0xfe, 0x57, 0x9a, 0x3f, 0x28, 0x70, 0x4d, 0x00, 0xeb, 0xc8, 0x22, 0x54, 0x37, 0x60, 0x5e, 0x44,
+	0x6e, 0x50, 0x64, 0x92, 0x88, 0xb8, 0x23, 0x73, 0xd4, 0x9b, 0x72, 0x26, 0x99, 0xc4, 0xf9, 0x64,
+	0x92, 0x51, 0x32, 0x9b, 0x70, 0xf3, 0x1c, 0x65, 0xfa, 0x2a, 0xfe, 0x4e, 0x81, 0xab, 0x43, 0xd8,
+	0xfd, 0xbe, 0xfb, 0xe2, 0x3d, 0x80, 0x49, 0x74, 0x7f, 0x8c, 0x54, 0xed, 0xf2, 0x9b, 0x97, 0xa5,
+	0x4c, 0x8c, 0x6a, 0x79, 0x88, 0x9a, 0x08, 0x70, 0x8e, 0x4a, 0x57, 0x21, 0x1f, 0xcf, 0xc0, 0x27,
+	0xf9, 0x4a, 0x81, 0xcb, 0x07, 0xd4, 0xd8, 0xc3, 0x0e, 0x1a, 0x1a, 0xc3, 0x2f, 0x70, 0x40, 0xd5,
+	0x5b, 0xb0, 0x20, 0x15, 0x47, 0x9c, 0x86, 0xd6, 0x6a, 0x39, 0x48, 0xa9, 0x94, 0xc0, 0xbc, 0xef,
+	0xd8, 0x11, 0x76, 0x75, 0x0b, 0x96, 0x88, 0xa3, 0xb7, 0x91, 0x32, 0x27, 0x84, 0x17, 0x74, 0x16,
+	0x83, 0x3e, 0x6f, 0xca, 0x26, 0xcc, 0xfb, 0x47, 0xe1, 0xc1, 0x85, 0x30, 0xfc, 0x23, 0xf2, 0xa0,
+	0x37, 0xe0, 0x12, 0xb2, 0x76, 0x23, 0xaa, 0x8e, 0x59, 0x64, 0xed, 0xba, 0x7f, 0x26, 0x59, 0xb8,
+	0x16, 0xd9, 0x82, 0xbf, 0xbd, 0x23, 0x58, 0x0c, 0xda, 0xdd, 0x39, 0x07, 0xd4, 0xb8, 0xd8, 0x0e,
+	0x97, 0x60, 0x32, 0xa8, 0x70, 0x31, 0x28, 0x1e, 0xc1, 0x95, 0x03, 0x6a, 0x78, 0x49, 0x7d, 0x80,
+	0xa6, 0xd1, 0x66, 0x5f, 0x11, 0x16, 0x16, 0x5a, 0x9b, 0x9b, 0x3d, 0x45, 0x62, 0x08, 0x7c, 0xe6,
+	0xd3, 0x58, 0x80, 0x95, 0xd8, 0xc8, 0xfe, 0xa6, 0x7e, 0x53, 0x60, 0xc5, 0x3f, 0xd6, 0xaa, 0xd6,
+	0xf2, 0x33, 0xb1, 0xdf, 0x37, 0x5b, 0xe8, 0x8a, 0xfd, 0x21, 0x4c, 0xd3, 0x5e, 0xf3, 0x09, 0xea,
+	0xa3, 0x15, 0x96, 0x7d, 0xf3, 0xb2, 0x74, 0x25, 0xa0, 0xb0, 0x2f, 0x7b, 0xcc, 0x20, 0xa6, 0x6d,
+	0x1c, 0x9e, 0xd4, 0xbc, 0xf9, 0xe1, 0xeb, 0x90, 0x88, 0x5c, 0x87, 0xf1, 0xaf, 0xf8, 0x69, 0xb9,
+	0x4a, 0x05, 0xcb, 0x95, 0xd4, 0xe9, 0x4d, 0x58, 0x1f, 0xb9, 0x21, 0x7f, 0xeb, 0x2f, 0x12, 0xb0,
+	0x20, 0x2a, 0xe7, 0x2e, 0xaf, 0x67, 0xe2, 0x3a, 0x15, 0x20, 0xcd, 0x6f, 0x43, 0xe8, 0x01, 0x00,
+	0x6e, 0x12, 0x97, 0x7f, 0xf8, 0x45, 0x4b, 0xc4, 0xbd, 0x68, 0xf7, 0x43, 0x8d, 0xc8, 0x4c, 0xb5,
+	0xec, 0x96, 0xc6, 0x3f, 0xdf, 0x15, 0x3e, 0x32, 0x4c, 0xd6, 0xee, 0x35, 0xcb, 0x3a, 0xb1, 0x64,
+	0xff, 0x25, 0xff, 0x94, 0x68, 0xeb, 0x69, 0x85, 0x0d, 0xba, 0x48, 0xcb, 0x0f, 0x6d, 0xe6, 0xf7,
+	0x25, 0xa1, 0xac, 0x04, 0x77, 0x7d, 0x9a, 0x15, 0x6e, 0x75, 0x81, 0xb2, 0xb9, 0x73, 0x50, 0x47,
+	0xb3, 0x8f, 0x4e, 0x66, 0x52, 0x00, 0x85, 0xb9, 0x26, 0xad, 0x71, 0xa2, 0x9a, 0x8a, 0x13, 0xd5,
+	0xbd, 0xd4, 0xdf, 0xcf, 0x0b, 0x4a, 0xf1, 0x67, 0x05, 0x54, 0xfe, 0xb2, 0xef, 0x9f, 0xa0, 0xde,
+	0x63, 0xd8, 0x12, 0x79, 0x1a, 0xff, 0x61, 0x0f, 0xa6, 0x33, 0x31, 0x94, 0xce, 0x18, 0x36, 0xc9,
+	0x58, 0x89, 0x47, 0x4a, 0x44, 0x2a, 0x5a, 0x22, 0x8a, 0xff, 0x2a, 0x90, 0x0d, 0x96, 0xd1, 0x30,
+	0xdf, 0x73, 0xcf, 0xd5, 0x88, 0x2d, 0xb3, 0x5c, 0xa5, 0xd5, 0x4f, 0xff, 0x79, 0x57, 0xb8, 0x1b,
+	0x38, 0x38, 0xc6, 0x53, 0x6e, 0x99, 0x36, 0x0b, 0xfe, 0xec, 0x98, 0x4d, 0x5a, 0x69, 0x0e, 0x18,
+	0xd2, 0xf2, 0x03, 0x3c, 0xa9, 0xba, 0x3f, 0xc6, 0x2f, 0xd0, 0xc9, 0x71, 0x0a, 0xb4, 0x4c, 0x50,
+	0x2a, 0x2e, 0x41, 0xc5, 0x9f, 0x12, 0xa0, 0xee, 0xd7, 0x76, 0xb7, 0x6f, 0xef, 0x61, 0xb7, 0x43,
+	0x06, 0x63, 0x6f, 0x7c, 0x0d, 0x66, 0x85, 0x42, 0x1a, 0xa2, 0xe7, 0x12, 0x72, 0x4e, 0x0b, 0xdb,
+	0x9e, 0x6b, 0x8a, 0x39, 0xec, 0x64, 0xdc, 0x61, 0xaf, 0x00, 0xa0, 0xa3, 0x6f, 0xdf, 0x6e, 0xd8,
+	0x9a, 0x85, 0x52, 0xa6, 0x33, 0xdc, 0xf2, 0x48, 0xb3, 0xf8, 0x42, 0xc2, 0x4d, 0x07, 0x56, 0x93,
+	0x74, 0xa4, 0x3c, 0xd3, 0xdc, 0x56, 0xe7, 0x26, 0x77, 0x21, 0x01, 0x69, 0xa1, 0x6e, 0x5a, 0x5a,
+	0x87, 0x4a, 0x69, 0x5e, 0xe2, 0xd6, 0x3d, 0x69, 0x8c, 0xcb, 0xc9, 0x74, 0x6c, 0x4e, 0x7e, 0x55,
+	0x20, 0x13, 0xa8, 0xf7, 0x17, 0x94, 0x44, 0x09, 0x16, 0x03, 0x1d, 0x01, 0x3b, 0x09, 0x89, 0x78,
+	0x9e, 0x9e, 0xc6, 0xbd, 0xa0, 0x94, 0xef, 0xc2, 0xb4, 0x85, 0x56, 0x13, 0x1d, 0x9a, 0x49, 0xad,
+	0x26, 0x37, 0xd2, 0xdb, 0xb9, 0x72, 0x4c, 0x6d, 0x16, 0xbc, 0x6b, 0x1e, 0x74, 0xfb, 0x97, 0x69,
+	0x48, 0xba, 0x05, 0xe7, 0x08, 0xe6, 0x22, 0xdf, 0x0c, 0x2b, 0xc1, 0xe9, 0x43, 0x5f, 0x21, 0xb9,
+	0xf5, 0x91, 0x6e, 0xff, 0x3d, 0x9c, 0x50, 0x9f, 0xc0, 0x52, 0xec, 0x37, 0xc9, 0x8d, 0x48, 0x80,
+	0x38, 0x50, 0xee, 0xd6, 0x18, 0xa0, 0xc0, 0x5a, 0x47, 0x30, 0x17, 0x69, 0xfa, 0xa3, 0xbb, 0x08,
+	0xbb, 0x73, 0xeb, 0x23, 0xdd, 0x81, 0xc8, 0xcf, 0x14, 0x58, 0x1e, 0xd9, 0xee, 0x47, 0x99, 0x8e,
+	0x02, 0xe7, 0xee, 0x5c, 0x00, 0x1c, 0x20, 0x61, 0xc0, 0x62, 0x5c, 0xb3, 0x56, 0x1c, 0x19, 0x8d,
+	0x63, 0x72, 0x1f, 0x9f, 0x8f, 0x09, 0x2c, 0xf4, 0x18, 0x2e, 0xd7, 0x91, 0x85, 0x7a, 0xae, 0xeb,
+	0x91, 0x00, 0x41, 0x67, 0xee, 0xc6, 0x08, 0x67, 0x48, 0x0a, 0x99, 0xf0, 0xba, 0x81, 0xae, 0x64,
+	0x2d, 0x12, 0x62, 0x18, 0x92, 0xdb, 0x3c, 0x17, 0x12, 0x58, 0x4b, 0x83, 0x85, 0xe1, 0x6f, 0xd4,
+	0xd5, 0x48, 0x84, 0x21, 0x44, 0x6e, 0xe3, 0x3c, 0x44, 0x60, 0x89, 0x6f, 0x21, 0x37, 0xa2, 0xc5,
+	0xd9, 0x8c, 0xcd, 0x78, 0x1c, 0x34, 0xb7, 0x35, 0x36, 0xf4, 0x74, 0xf5, 0xea, 0xe3, 0xd7, 0xef,
+	0xf3, 0xca, 0xdb, 0xf7, 0x79, 0xe5, 0xaf, 0xf7, 0x79, 0xe5, 0xc7, 0x0f, 0xf9, 0x89, 0xb7, 0x1f,
+	0xf2, 0x13, 0x7f, 0x7c, 0xc8, 0x4f, 0x7c, 0xfd, 0x59, 0xa0, 0xa8, 0x74, 0xd1, 0x30, 0x06, 0x4f,
+	0xfa, 0xde, 0x7f, 0x4b, 0x4a, 0xe2, 0x7f, 0x0b, 0x15, 0x8b, 0xb4, 0x7a, 0x1d, 0xac, 0xf4, 0xb7,
+	0x2b, 0x27, 0x9e, 0x4b, 0xb4, 0x09, 0xcd, 0x29, 0xde, 0x80, 0xdd, 0xf9, 0x6f, 0x00, 0x36, 0xb1,
+	0x24, 0x85, 0x18, 0x12, 0x00, 0x00,
 }
 
 func (this *SendToCosmosEvent) Equal(that interface{}) bool {
@@ -1499,6 +1691,8 @@ type MsgClient interface {
 	SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error)
 	SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error)
 	SubmitEthereumHeightVote(ctx context.Context, in *MsgEthereumHeightVote, opts ...grpc.CallOption) (*MsgEthereumHeightVoteResponse, error)
+	IncreaseBridgeFee(ctx context.Context, in *MsgIncreaseBridgeFee, opts ...grpc.CallOption) (*MsgIncreaseBridgeFeeResponse, error)
+	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
 }
 
 type msgClient struct {
@@ -1572,6 +1766,24 @@ func (c *msgClient) SubmitEthereumHeightVote(ctx context.Context, in *MsgEthereu
 	return out, nil
 }
 
+func (c *msgClient) IncreaseBridgeFee(ctx context.Context, in *MsgIncreaseBridgeFee, opts ...grpc.CallOption) (*MsgIncreaseBridgeFeeResponse, error) {
+	out := new(MsgIncreaseBridgeFeeResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/IncreaseBridgeFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error) {
+	out := new(MsgSubmitBadSignatureEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitBadSignatureEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SendToEthereum(context.Context, *MsgSendToEthereum) (*MsgSendToEthereumResponse, error)
@@ -1581,6 +1793,8 @@ type MsgServer interface {
 	SubmitEthereumEvent(context.Context, *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error)
 	SetDelegateKeys(context.Context, *MsgDelegateKeys) (*MsgDelegateKeysResponse, error)
 	SubmitEthereumHeightVote(context.Context, *MsgEthereumHeightVote) (*MsgEthereumHeightVoteResponse, error)
+	IncreaseBridgeFee(context.Context, *MsgIncreaseBridgeFee) (*MsgIncreaseBridgeFeeResponse, error)
+	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1608,6 +1822,12 @@ func (*UnimplementedMsgServer) SetDelegateKeys(ctx context.Context, req *MsgDele
 func (*UnimplementedMsgServer) SubmitEthereumHeightVote(ctx context.Context, req *MsgEthereumHeightVote) (*MsgEthereumHeightVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumHeightVote not implemented")
 }
+func (*UnimplementedMsgServer) IncreaseBridgeFee(ctx context.Context, req *MsgIncreaseBridgeFee) (*MsgIncreaseBridgeFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseBridgeFee not implemented")
+}
+func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1739,6 +1959,42 @@ func _Msg_SubmitEthereumHeightVote_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_IncreaseBridgeFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreaseBridgeFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseBridgeFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/IncreaseBridgeFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseBridgeFee(ctx, req.(*MsgIncreaseBridgeFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBadSignatureEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBadSignatureEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitBadSignatureEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBadSignatureEvidence(ctx, req.(*MsgSubmitBadSignatureEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gravity.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1771,6 +2027,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEthereumHeightVote",
 			Handler:    _Msg_SubmitEthereumHeightVote_Handler,
 		},
+		{
+			MethodName: "IncreaseBridgeFee",
+			Handler:    _Msg_IncreaseBridgeFee_Handler,
+		},
+		{
+			MethodName: "SubmitBadSignatureEvidence",
+			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -1919,6 +2183,74 @@ func (m *MsgCancelSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgIncreaseBridgeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseBridgeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseBridgeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeIncrease.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgRequestBatchTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2416,7 +2748,7 @@ func (m *MsgEthereumHeightVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2426,24 +2758,103 @@ func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SendToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x22
 	}
-	if len(m.CosmosReceiver) > 0 {
-		i -= len(m.CosmosReceiver)
-		copy(dAtA[i:], m.CosmosReceiver)
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Subject != nil {
+		{
+			size, err := m.Subject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
 		i--
 		dAtA[i] = 0x2a
@@ -2757,6 +3168,33 @@ func (m *MsgCancelSendToEthereumResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgIncreaseBridgeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgs(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.FeeIncrease.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgRequestBatchTx) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2978,6 +3416,40 @@ func (m *MsgEthereumHeightVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subject != nil {
+		l = m.Subject.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *SendToCosmosEvent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3412,11 +3884,194 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseBridgeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseBridgeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseBridgeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeIncrease", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3426,23 +4081,24 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.FeeIncrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3465,7 +4121,7 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIncreaseBridgeFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3488,10 +4144,10 @@ func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIncreaseBridgeFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIncreaseBridgeFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4968,6 +5624,240 @@ func (m *MsgEthereumHeightVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Subject == nil {
+				m.Subject = &types1.Any{}
+			}
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -12,13 +13,31 @@ import (
 const (
 	// ProposalTypeCommunityPoolEthereumSpend defines the type for a CommunityPoolEthereumSpendProposal
 	ProposalTypeCommunityPoolEthereumSpend = "CommunityPoolEthereumSpend"
+	// ProposalTypeReleaseQuarantinedDeposits defines the type for a ReleaseQuarantinedDepositsProposal
+	ProposalTypeReleaseQuarantinedDeposits = "ReleaseQuarantinedDeposits"
+	// ProposalTypeReturnQuarantinedDeposits defines the type for a ReturnQuarantinedDepositsProposal
+	ProposalTypeReturnQuarantinedDeposits = "ReturnQuarantinedDeposits"
+	// ProposalTypeAddEthereumDenylist defines the type for a AddEthereumDenylistProposal
+	ProposalTypeAddEthereumDenylist = "AddEthereumDenylist"
+	// ProposalTypeRemoveEthereumDenylist defines the type for a RemoveEthereumDenylistProposal
+	ProposalTypeRemoveEthereumDenylist = "RemoveEthereumDenylist"
 )
 
-// Assert CommunityPoolEthereumSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
+	_ govtypes.Content = &ReleaseQuarantinedDepositsProposal{}
+	_ govtypes.Content = &ReturnQuarantinedDepositsProposal{}
+	_ govtypes.Content = &AddEthereumDenylistProposal{}
+	_ govtypes.Content = &RemoveEthereumDenylistProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolEthereumSpend)
+	govtypes.RegisterProposalType(ProposalTypeReleaseQuarantinedDeposits)
+	govtypes.RegisterProposalType(ProposalTypeReturnQuarantinedDeposits)
+	govtypes.RegisterProposalType(ProposalTypeAddEthereumDenylist)
+	govtypes.RegisterProposalType(ProposalTypeRemoveEthereumDenylist)
 }
 
 // NewCommunityPoolEthereumSpendProposal creates a new community pool spend proposal.
@@ -80,3 +99,183 @@ func (csp CommunityPoolEthereumSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.BridgeFee))
 	return b.String()
 }
+
+// NewReleaseQuarantinedDepositsProposal creates a new proposal crediting quarantined deposits.
+func NewReleaseQuarantinedDepositsProposal(title, description string, ids []uint64) *ReleaseQuarantinedDepositsProposal {
+	return &ReleaseQuarantinedDepositsProposal{title, description, ids}
+}
+
+// GetTitle returns the title of a release quarantined deposits proposal.
+func (p *ReleaseQuarantinedDepositsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a release quarantined deposits proposal.
+func (p *ReleaseQuarantinedDepositsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a release quarantined deposits proposal.
+func (p *ReleaseQuarantinedDepositsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a release quarantined deposits proposal.
+func (p *ReleaseQuarantinedDepositsProposal) ProposalType() string {
+	return ProposalTypeReleaseQuarantinedDeposits
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ReleaseQuarantinedDepositsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validateQuarantinedDepositIDs(p.Ids)
+}
+
+// String implements the Stringer interface.
+func (p ReleaseQuarantinedDepositsProposal) String() string {
+	return fmt.Sprintf(`Release Quarantined Deposits Proposal:
+  Title:       %s
+  Description: %s
+  IDs:         %v
+`, p.Title, p.Description, p.Ids)
+}
+
+// NewReturnQuarantinedDepositsProposal creates a new proposal returning quarantined deposits to Ethereum.
+func NewReturnQuarantinedDepositsProposal(title, description string, ids []uint64) *ReturnQuarantinedDepositsProposal {
+	return &ReturnQuarantinedDepositsProposal{title, description, ids}
+}
+
+// GetTitle returns the title of a return quarantined deposits proposal.
+func (p *ReturnQuarantinedDepositsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a return quarantined deposits proposal.
+func (p *ReturnQuarantinedDepositsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a return quarantined deposits proposal.
+func (p *ReturnQuarantinedDepositsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a return quarantined deposits proposal.
+func (p *ReturnQuarantinedDepositsProposal) ProposalType() string {
+	return ProposalTypeReturnQuarantinedDeposits
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ReturnQuarantinedDepositsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validateQuarantinedDepositIDs(p.Ids)
+}
+
+// String implements the Stringer interface.
+func (p ReturnQuarantinedDepositsProposal) String() string {
+	return fmt.Sprintf(`Return Quarantined Deposits Proposal:
+  Title:       %s
+  Description: %s
+  IDs:         %v
+`, p.Title, p.Description, p.Ids)
+}
+
+func validateQuarantinedDepositIDs(ids []uint64) error {
+	if len(ids) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no quarantined deposit ids")
+	}
+	seen := make(map[uint64]bool, len(ids))
+	for _, id := range ids {
+		if id == 0 || seen[id] {
+			return sdkerrors.Wrapf(ErrInvalid, "quarantined deposit id %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// NewAddEthereumDenylistProposal creates a new proposal denying withdrawals to ethereum addresses.
+func NewAddEthereumDenylistProposal(title, description string, addresses []string) *AddEthereumDenylistProposal {
+	return &AddEthereumDenylistProposal{title, description, addresses}
+}
+
+// GetTitle returns the title of an add ethereum denylist proposal.
+func (p *AddEthereumDenylistProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add ethereum denylist proposal.
+func (p *AddEthereumDenylistProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add ethereum denylist proposal.
+func (p *AddEthereumDenylistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add ethereum denylist proposal.
+func (p *AddEthereumDenylistProposal) ProposalType() string {
+	return ProposalTypeAddEthereumDenylist
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddEthereumDenylistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Addresses) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no ethereum addresses")
+	}
+	return ValidateEthereumDenylist(p.Addresses)
+}
+
+// String implements the Stringer interface.
+func (p AddEthereumDenylistProposal) String() string {
+	return fmt.Sprintf(`Add Ethereum Denylist Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %v
+`, p.Title, p.Description, p.Addresses)
+}
+
+// NewRemoveEthereumDenylistProposal creates a new proposal allowing withdrawals to denylisted ethereum addresses again.
+func NewRemoveEthereumDenylistProposal(title, description string, addresses []string) *RemoveEthereumDenylistProposal {
+	return &RemoveEthereumDenylistProposal{title, description, addresses}
+}
+
+// GetTitle returns the title of a remove ethereum denylist proposal.
+func (p *RemoveEthereumDenylistProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove ethereum denylist proposal.
+func (p *RemoveEthereumDenylistProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove ethereum denylist proposal.
+func (p *RemoveEthereumDenylistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove ethereum denylist proposal.
+func (p *RemoveEthereumDenylistProposal) ProposalType() string {
+	return ProposalTypeRemoveEthereumDenylist
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveEthereumDenylistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Addresses) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no ethereum addresses")
+	}
+	return ValidateEthereumDenylist(p.Addresses)
+}
+
+// String implements the Stringer interface.
+func (p RemoveEthereumDenylistProposal) String() string {
+	return fmt.Sprintf(`Remove Ethereum Denylist Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %v
+`, p.Title, p.Description, p.Addresses)
+}
+
+// ValidateEthereumDenylist checks a list of ethereum addresses holds no invalid or duplicate address
+func ValidateEthereumDenylist(addresses []string) error {
+	seen := make(map[common.Address]bool, len(addresses))
+	for _, address := range addresses {
+		if err := ValidateEthAddress(address); err != nil {
+			return sdkerrors.Wrapf(ErrInvalid, "ethereum address %s: %s", address, err)
+		}
+		addr := common.HexToAddress(address)
+		if seen[addr] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate ethereum address %s", address)
+		}
+		seen[addr] = true
+	}
+	return nil
+}